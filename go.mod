@@ -24,6 +24,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/chimesdkvoice v1.12.5
 	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.15.6
+	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.1.5
 	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.30.1
 	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.10.5
 	github.com/aws/aws-sdk-go-v2/service/codedeploy v1.22.2
@@ -103,6 +104,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/wellarchitected v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.35.6
 	github.com/aws/aws-sdk-go-v2/service/xray v1.23.6
+	github.com/aws/smithy-go v1.19.0
 	github.com/beevik/etree v1.3.0
 	github.com/davecgh/go-spew v1.1.1
 	github.com/gertd/go-pluralize v0.2.1
@@ -165,7 +167,6 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.16.9 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sso v1.18.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.21.5 // indirect
-	github.com/aws/smithy-go v1.19.0 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
 	github.com/bufbuild/protocompile v0.6.0 // indirect
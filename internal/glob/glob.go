@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glob
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Compile converts a glob pattern matching `/`-separated paths to a regular expression.
+// `*` matches any sequence of characters other than `/`, `?` matches any single character other than `/`
+// and `**` matches any sequence of characters, including `/`. `**/` also matches the empty string.
+// All other characters match themselves.
+func Compile(pattern string) *regexp.Regexp {
+	var b strings.Builder
+
+	runes := []rune(pattern)
+	b.WriteString("^")
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				i++
+				if i+1 < len(runes) && runes[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return regexp.MustCompile(b.String())
+}
+
+// MatchAny returns whether the `/`-separated path `name` matches any of the compiled patterns.
+func MatchAny(res []*regexp.Regexp, name string) bool {
+	for _, re := range res {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glob
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{pattern: "*.html", name: "index.html", expected: true},
+		{pattern: "*.html", name: "docs/index.html", expected: false},
+		{pattern: "**/*.html", name: "index.html", expected: true},
+		{pattern: "**/*.html", name: "docs/v1/index.html", expected: true},
+		{pattern: "**/*.html", name: "index.htm", expected: false},
+		{pattern: "docs/**", name: "docs/v1/index.html", expected: true},
+		{pattern: "docs/**", name: "images/logo.png", expected: false},
+		{pattern: "img/logo.???", name: "img/logo.png", expected: true},
+		{pattern: "img/logo.???", name: "img/logo.jpeg", expected: false},
+		{pattern: "?.txt", name: "/.txt", expected: false},
+		{pattern: "a+b(c).txt", name: "a+b(c).txt", expected: true},
+		{pattern: "données/*", name: "données/été.txt", expected: true},
+		{pattern: ".git/**", name: ".gitignore", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s %s", testCase.pattern, testCase.name), func(t *testing.T) {
+			t.Parallel()
+
+			if got := Compile(testCase.pattern).MatchString(testCase.name); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMatchAny(t *testing.T) {
+	t.Parallel()
+
+	res := []*regexp.Regexp{Compile("**/*.pyc"), Compile(".git/**")}
+
+	testCases := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "handler.py", expected: false},
+		{name: "__pycache__/handler.cpython-312.pyc", expected: true},
+		{name: ".git/HEAD", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := MatchAny(res, testCase.name); got != testCase.expected {
+			t.Errorf("%s: got %t, expected %t", testCase.name, got, testCase.expected)
+		}
+	}
+
+	if MatchAny(nil, "handler.py") {
+		t.Error("no patterns: got true, expected false")
+	}
+}
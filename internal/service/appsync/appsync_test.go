@@ -67,6 +67,7 @@ func TestAccAppSync_serial(t *testing.T) {
 			"AdditionalAuthentication_multiple":                   testAccGraphQLAPI_AdditionalAuthentication_multiple,
 			"xrayEnabled":                                         testAccGraphQLAPI_xrayEnabled,
 			"visibility":                                          testAccGraphQLAPI_visibility,
+			"apiTypeMerged":                                       testAccGraphQLAPI_apiTypeMerged,
 		},
 		"Function": {
 			"basic":                   testAccFunction_basic,
@@ -106,6 +107,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			"basic":      testAccDomainNameAPIAssociation_basic,
 			"disappears": testAccDomainNameAPIAssociation_disappears,
 		},
+		"SourceAPIAssociation": {
+			"basic":       testAccSourceAPIAssociation_basic,
+			"disappears":  testAccSourceAPIAssociation_disappears,
+			"manualMerge": testAccSourceAPIAssociation_manualMerge,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -127,6 +128,13 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"api_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appsync.GraphQLApiTypeGraphql,
+				ValidateFunc: validation.StringInSlice(appsync.GraphQLApiType_Values(), false),
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -183,6 +191,11 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"merged_api_execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -270,8 +283,34 @@ func ResourceGraphQLAPI() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffGraphQLAPIType,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func customizeDiffGraphQLAPIType(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("merged_api_execution_role_arn") {
+		return nil
+	}
+
+	switch apiType := d.Get("api_type").(string); apiType {
+	case appsync.GraphQLApiTypeMerged:
+		if v, ok := d.GetOk("merged_api_execution_role_arn"); !ok || v.(string) == "" {
+			return fmt.Errorf(`"merged_api_execution_role_arn" is required when "api_type" is %q`, apiType)
+		}
+		// The schema of a Merged API is derived from its source API associations.
+		if v, ok := d.GetOk("schema"); ok && v.(string) != "" {
+			return fmt.Errorf(`"schema" cannot be set when "api_type" is %q`, apiType)
+		}
+	default:
+		if v, ok := d.GetOk("merged_api_execution_role_arn"); ok && v.(string) != "" {
+			return fmt.Errorf(`"merged_api_execution_role_arn" can only be set when "api_type" is %q`, appsync.GraphQLApiTypeMerged)
+		}
 	}
+
+	return nil
 }
 
 func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -280,6 +319,7 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 
 	name := d.Get("name").(string)
 	input := &appsync.CreateGraphqlApiInput{
+		ApiType:            aws.String(d.Get("api_type").(string)),
 		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
 		Name:               aws.String(name),
 		Tags:               getTagsIn(ctx),
@@ -297,6 +337,10 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 		input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+		input.MergedApiExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("openid_connect_config"); ok {
 		input.OpenIDConnectConfig = expandGraphQLAPIOpenIDConnectConfig(v.([]interface{}))
 	}
@@ -349,6 +393,7 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 	if err := d.Set("additional_authentication_provider", flattenGraphQLAPIAdditionalAuthenticationProviders(api.AdditionalAuthenticationProviders)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_authentication_provider: %s", err)
 	}
+	d.Set("api_type", api.ApiType)
 	d.Set("arn", api.Arn)
 	d.Set("authentication_type", api.AuthenticationType)
 	if err := d.Set("lambda_authorizer_config", flattenGraphQLAPILambdaAuthorizerConfig(api.LambdaAuthorizerConfig)); err != nil {
@@ -360,6 +405,7 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 	if err := d.Set("openid_connect_config", flattenGraphQLAPIOpenIDConnectConfig(api.OpenIDConnectConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting openid_connect_config: %s", err)
 	}
+	d.Set("merged_api_execution_role_arn", api.MergedApiExecutionRoleArn)
 	d.Set("name", api.Name)
 	d.Set("uris", aws.StringValueMap(api.Uris))
 	if err := d.Set("user_pool_config", flattenGraphQLAPIUserPoolConfig(api.UserPoolConfig)); err != nil {
@@ -398,6 +444,10 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 		}
 
+		if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+			input.MergedApiExecutionRoleArn = aws.String(v.(string))
+		}
+
 		if v, ok := d.GetOk("openid_connect_config"); ok {
 			input.OpenIDConnectConfig = expandGraphQLAPIOpenIDConnectConfig(v.([]interface{}))
 		}
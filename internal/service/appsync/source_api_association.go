@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	sourceAPIAssociationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_appsync_source_api_association", name="Source API Association")
+func ResourceSourceAPIAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSourceAPIAssociationCreate,
+		ReadWithoutTimeout:   resourceSourceAPIAssociationRead,
+		UpdateWithoutTimeout: resourceSourceAPIAssociationUpdate,
+		DeleteWithoutTimeout: resourceSourceAPIAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"merged_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_association_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      appsync.MergeTypeAutoMerge,
+							ValidateFunc: validation.StringInSlice(appsync.MergeType_Values(), false),
+						},
+					},
+				},
+			},
+			"source_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSourceAPIAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	mergedAPIID, sourceAPIID := d.Get("merged_api_id").(string), d.Get("source_api_id").(string)
+	input := &appsync.AssociateSourceGraphqlApiInput{
+		MergedApiIdentifier:        aws.String(mergedAPIID),
+		SourceApiAssociationConfig: expandSourceAPIAssociationConfig(d.Get("source_api_association_config").([]interface{})),
+		SourceApiIdentifier:        aws.String(sourceAPIID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.AssociateSourceGraphqlApiWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppSync Source API Association (%s/%s): %s", mergedAPIID, sourceAPIID, err)
+	}
+
+	associationID := aws.StringValue(output.SourceApiAssociation.AssociationId)
+	id, err := flex.FlattenResourceId([]string{mergedAPIID, associationID}, sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if err := mergeSourceAPIAssociation(ctx, conn, mergedAPIID, associationID, output.SourceApiAssociation, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSourceAPIAssociationRead(ctx, d, meta)...)
+}
+
+func resourceSourceAPIAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	mergedAPIID, associationID := parts[0], parts[1]
+	association, err := FindSourceAPIAssociationByTwoPartKey(ctx, conn, mergedAPIID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppSync Source API Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("description", association.Description)
+	d.Set("merged_api_arn", association.MergedApiArn)
+	d.Set("merged_api_id", association.MergedApiId)
+	d.Set("source_api_arn", association.SourceApiArn)
+	if err := d.Set("source_api_association_config", flattenSourceAPIAssociationConfig(association.SourceApiAssociationConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_api_association_config: %s", err)
+	}
+	d.Set("source_api_id", association.SourceApiId)
+
+	// Surface merge conflicts that occurred outside of Terraform (e.g. an auto merge triggered by a source API change).
+	if status := aws.StringValue(association.SourceApiAssociationStatus); status == appsync.SourceApiAssociationStatusMergeFailed || status == appsync.SourceApiAssociationStatusAutoMergeScheduleFailed {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("AppSync Source API Association (%s) merge failed", d.Id()),
+			Detail:   aws.StringValue(association.SourceApiAssociationStatusDetail),
+		})
+	}
+
+	return diags
+}
+
+func resourceSourceAPIAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	mergedAPIID, associationID := parts[0], parts[1]
+	input := &appsync.UpdateSourceApiAssociationInput{
+		AssociationId:              aws.String(associationID),
+		Description:                aws.String(d.Get("description").(string)),
+		MergedApiIdentifier:        aws.String(mergedAPIID),
+		SourceApiAssociationConfig: expandSourceAPIAssociationConfig(d.Get("source_api_association_config").([]interface{})),
+	}
+
+	output, err := conn.UpdateSourceApiAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	if err := mergeSourceAPIAssociation(ctx, conn, mergedAPIID, associationID, output.SourceApiAssociation, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSourceAPIAssociationRead(ctx, d, meta)...)
+}
+
+func resourceSourceAPIAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppSyncConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), sourceAPIAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	mergedAPIID, associationID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting AppSync Source API Association: %s", d.Id())
+	_, err = conn.DisassociateSourceGraphqlApiWithContext(ctx, &appsync.DisassociateSourceGraphqlApiInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppSync Source API Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSourceAPIAssociationDeleted(ctx, conn, mergedAPIID, associationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppSync Source API Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// mergeSourceAPIAssociation waits for the source API's schema to be merged into the Merged API.
+// Auto merges are started by AppSync; manual merges must be explicitly requested.
+func mergeSourceAPIAssociation(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string, association *appsync.SourceApiAssociation, timeout time.Duration) error {
+	if association != nil && association.SourceApiAssociationConfig != nil && aws.StringValue(association.SourceApiAssociationConfig.MergeType) == appsync.MergeTypeManualMerge {
+		_, err := conn.StartSchemaMergeWithContext(ctx, &appsync.StartSchemaMergeInput{
+			AssociationId:       aws.String(associationID),
+			MergedApiIdentifier: aws.String(mergedAPIID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("starting schema merge: %w", err)
+		}
+	}
+
+	if _, err := waitSourceAPIAssociationMerged(ctx, conn, mergedAPIID, associationID, timeout); err != nil {
+		return fmt.Errorf("waiting for schema merge: %w", err)
+	}
+
+	return nil
+}
+
+func FindSourceAPIAssociationByTwoPartKey(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	input := &appsync.GetSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+
+	output, err := conn.GetSourceApiAssociationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SourceApiAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SourceApiAssociation, nil
+}
+
+func statusSourceAPIAssociation(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSourceAPIAssociationByTwoPartKey(ctx, conn, mergedAPIID, associationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SourceApiAssociationStatus), nil
+	}
+}
+
+func waitSourceAPIAssociationMerged(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string, timeout time.Duration) (*appsync.SourceApiAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusMergeScheduled, appsync.SourceApiAssociationStatusMergeInProgress},
+		Target:  []string{appsync.SourceApiAssociationStatusMergeSuccess},
+		Refresh: statusSourceAPIAssociation(ctx, conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		switch status := aws.StringValue(output.SourceApiAssociationStatus); status {
+		case appsync.SourceApiAssociationStatusMergeFailed, appsync.SourceApiAssociationStatusAutoMergeScheduleFailed:
+			// The status detail describes the schema merge conflict.
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSourceAPIAssociationDeleted(ctx context.Context, conn *appsync.AppSync, mergedAPIID, associationID string, timeout time.Duration) (*appsync.SourceApiAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusDeletionScheduled, appsync.SourceApiAssociationStatusDeletionInProgress},
+		Target:  []string{},
+		Refresh: statusSourceAPIAssociation(ctx, conn, mergedAPIID, associationID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		if status := aws.StringValue(output.SourceApiAssociationStatus); status == appsync.SourceApiAssociationStatusDeletionFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSourceAPIAssociationConfig(tfList []interface{}) *appsync.SourceApiAssociationConfig {
+	if len(tfList) < 1 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appsync.SourceApiAssociationConfig{}
+
+	if v, ok := tfMap["merge_type"].(string); ok && v != "" {
+		apiObject.MergeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceAPIAssociationConfig(apiObject *appsync.SourceApiAssociationConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"merge_type": aws.StringValue(apiObject.MergeType),
+	}
+
+	return []interface{}{tfMap}
+}
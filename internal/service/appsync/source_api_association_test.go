@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appsync"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfappsync "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccSourceAPIAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "association_id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_arn", "aws_appsync_graphql_api.merged", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "merged_api_id", "aws_appsync_graphql_api.merged", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_arn", "aws_appsync_graphql_api.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_api_id", "aws_appsync_graphql_api.source", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "AUTO_MERGE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_basic(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappsync.ResourceSourceAPIAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceAPIAssociation_manualMerge(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association appsync.SourceApiAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_source_api_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSourceAPIAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAPIAssociationConfig_mergeType(rName, "MANUAL_MERGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "MANUAL_MERGE"),
+				),
+			},
+			{
+				Config: testAccSourceAPIAssociationConfig_mergeType(rName, "AUTO_MERGE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceAPIAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "source_api_association_config.0.merge_type", "AUTO_MERGE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSourceAPIAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appsync_source_api_association" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfappsync.FindSourceAPIAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppSync Source API Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSourceAPIAssociationExists(ctx context.Context, n string, v *appsync.SourceApiAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn(ctx)
+
+		output, err := tfappsync.FindSourceAPIAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceAPIAssociationConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccGraphQLAPIConfig_mergedExecutionRole(rName), fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "merged" {
+  api_type                      = "MERGED"
+  authentication_type           = "API_KEY"
+  merged_api_execution_role_arn = aws_iam_role.test.arn
+  name                          = "%[1]s-merged"
+}
+
+resource "aws_appsync_graphql_api" "source" {
+  authentication_type = "API_KEY"
+  name                = "%[1]s-source"
+  schema              = "type Post {\n\tid: ID!\n\ttitle: String!\n}\n\ntype Query {\n\tsinglePost(id: ID!): Post\n}\n\nschema {\n\tquery: Query\n}\n"
+}
+`, rName))
+}
+
+func testAccSourceAPIAssociationConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appsync_source_api_association" "test" {
+  description   = %[1]q
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, description))
+}
+
+func testAccSourceAPIAssociationConfig_mergeType(rName, mergeType string) string {
+	return acctest.ConfigCompose(testAccSourceAPIAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_appsync_source_api_association" "test" {
+  merged_api_id = aws_appsync_graphql_api.merged.id
+  source_api_id = aws_appsync_graphql_api.source.id
+
+  source_api_association_config {
+    merge_type = %[1]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, mergeType))
+}
@@ -113,3 +113,21 @@ func lifecycleHookLifecycleTransition_Values() []string {
 		lifecycleHookLifecycleTransitionInstanceTerminating,
 	}
 }
+
+const (
+	instanceRefreshOnFailureCancel   = "cancel"
+	instanceRefreshOnFailureRollback = "rollback"
+)
+
+func instanceRefreshOnFailure_Values() []string {
+	return []string{
+		instanceRefreshOnFailureCancel,
+		instanceRefreshOnFailureRollback,
+	}
+}
+
+const (
+	// instanceRefreshFailureActionRefreshPrevious starts a new instance refresh to the previous configuration.
+	// The other failure actions are the on_failure values.
+	instanceRefreshFailureActionRefreshPrevious = "refresh-previous"
+)
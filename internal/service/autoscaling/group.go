@@ -19,6 +19,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -223,6 +224,11 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"on_failure": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(instanceRefreshOnFailure_Values(), false),
+						},
 						"preferences": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -295,6 +301,11 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -1631,9 +1642,34 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				mixedInstancesPolicy = expandMixedInstancesPolicy(v.([]interface{})[0].(map[string]interface{}))
 			}
 
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy)); err != nil {
+			id, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if tfMap["wait_for_completion"].(bool) {
+				if output, err := waitInstanceRefreshSuccessful(ctx, conn, d.Id(), id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", d.Id(), id, err)
+
+					switch instanceRefreshFailureAction(tfMap["on_failure"].(string), output, err) {
+					case instanceRefreshOnFailureCancel:
+						if err := cancelInstanceRefresh(ctx, conn, d.Id()); err != nil {
+							diags = sdkdiag.AppendFromErr(diags, err)
+						}
+					case instanceRefreshOnFailureRollback:
+						if err := rollbackInstanceRefresh(ctx, conn, d.Id()); err != nil {
+							diags = sdkdiag.AppendFromErr(diags, err)
+						}
+					case instanceRefreshFailureActionRefreshPrevious:
+						if err := refreshInstancesToPreviousConfiguration(ctx, conn, d, tfMap); err != nil {
+							diags = sdkdiag.AppendFromErr(diags, err)
+						}
+					}
+
+					return diags
+				}
+			}
 		}
 	}
 
@@ -2459,6 +2495,25 @@ func statusInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 	}
 }
 
+// statusInstanceRefreshWithProgress wraps statusInstanceRefresh, logging the instance refresh's progress.
+func statusInstanceRefreshWithProgress(ctx context.Context, conn *autoscaling.AutoScaling, name, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, status, err := statusInstanceRefresh(ctx, conn, name, id)()
+
+		if v, ok := output.(*autoscaling.InstanceRefresh); ok {
+			tflog.Info(ctx, "Auto Scaling Group instance refresh progress", map[string]any{
+				"auto_scaling_group_name": name,
+				"instance_refresh_id":     id,
+				"instances_to_update":     aws.Int64Value(v.InstancesToUpdate),
+				"percentage_complete":     aws.Int64Value(v.PercentageComplete),
+				"status":                  status,
+			})
+		}
+
+		return output, status, err
+	}
+}
+
 func statusLoadBalancerInStateCount(ctx context.Context, conn *autoscaling.AutoScaling, name string, states ...string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findLoadBalancerStates(ctx, conn, name)
@@ -2708,6 +2763,9 @@ const (
 
 	// Maximum amount of time to wait for an Instance Refresh to be Cancelled
 	instanceRefreshCancelledTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for an Instance Refresh to be Rolled Back
+	instanceRefreshRolledBackTimeout = 15 * time.Minute
 )
 
 func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
@@ -2735,6 +2793,53 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoSca
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusSuccessful},
+		Refresh: statusInstanceRefreshWithProgress(ctx, conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		if v := aws.StringValue(output.StatusReason); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInstanceRefreshRolledBack(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
+		},
+		Target:  []string{autoscaling.InstanceRefreshStatusRollbackSuccessful},
+		Refresh: statusInstanceRefreshWithProgress(ctx, conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.AutoScaling, name string, timeout time.Duration) (*autoscaling.WarmPoolConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{autoscaling.WarmPoolStatusPendingDelete},
@@ -4043,10 +4148,106 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) error {
+func rollbackInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, name string) error {
+	input := &autoscaling.RollbackInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+	}
+
+	output, err := conn.RollbackInstanceRefreshWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeActiveInstanceRefreshNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("rolling back Auto Scaling Group (%s) instance refresh: %w", name, err)
+	}
+
+	_, err = waitInstanceRefreshRolledBack(ctx, conn, name, aws.StringValue(output.InstanceRefreshId), instanceRefreshRolledBackTimeout)
+
+	if err != nil {
+		return fmt.Errorf("waiting for Auto Scaling Group (%s) instance refresh rollback: %w", name, err)
+	}
+
+	return nil
+}
+
+// instanceRefreshFailureAction returns the action that implements the configured on_failure behavior
+// after waiting for an instance refresh to succeed returned the specified error.
+// An instance refresh that timed out is still active and is cancelled or rolled back.
+// An instance refresh that failed or was cancelled is no longer active, so a rollback
+// starts a new instance refresh to the previous configuration.
+func instanceRefreshFailureAction(onFailure string, refresh *autoscaling.InstanceRefresh, err error) string {
+	if err == nil {
+		return ""
+	}
+
+	// The timeout error's LastError is set to the instance refresh's status reason, so tfresource.TimedOut can't be used.
+	if timeoutErr := (*retry.TimeoutError)(nil); errors.As(err, &timeoutErr) {
+		switch onFailure {
+		case instanceRefreshOnFailureCancel, instanceRefreshOnFailureRollback:
+			return onFailure
+		}
+
+		return ""
+	}
+
+	if refresh == nil || onFailure != instanceRefreshOnFailureRollback {
+		return ""
+	}
+
+	switch aws.StringValue(refresh.Status) {
+	case autoscaling.InstanceRefreshStatusCancelled, autoscaling.InstanceRefreshStatusFailed:
+		return instanceRefreshFailureActionRefreshPrevious
+	}
+
+	return ""
+}
+
+// refreshInstancesToPreviousConfiguration starts an instance refresh to the Auto Scaling group's previous
+// launch template or mixed instances policy and waits for it to complete.
+func refreshInstancesToPreviousConfiguration(ctx context.Context, conn *autoscaling.AutoScaling, d *schema.ResourceData, tfMap map[string]interface{}) error {
+	name := d.Id()
+
+	if !d.HasChanges("launch_template", "mixed_instances_policy") {
+		return fmt.Errorf("rolling back Auto Scaling Group (%s) instance refresh: launch_template and mixed_instances_policy are unchanged, no previous configuration to roll back to", name)
+	}
+
+	var launchTemplate *autoscaling.LaunchTemplateSpecification
+
+	if o, _ := d.GetChange("launch_template"); len(o.([]interface{})) > 0 && o.([]interface{})[0] != nil {
+		launchTemplate = expandLaunchTemplateSpecification(o.([]interface{})[0].(map[string]interface{}))
+	}
+
+	var mixedInstancesPolicy *autoscaling.MixedInstancesPolicy
+
+	if o, _ := d.GetChange("mixed_instances_policy"); len(o.([]interface{})) > 0 && o.([]interface{})[0] != nil {
+		mixedInstancesPolicy = expandMixedInstancesPolicy(o.([]interface{})[0].(map[string]interface{}))
+	}
+
+	input := expandStartInstanceRefreshInput(name, tfMap, launchTemplate, mixedInstancesPolicy)
+	input.DesiredConfiguration = &autoscaling.DesiredConfiguration{
+		LaunchTemplate:       launchTemplate,
+		MixedInstancesPolicy: mixedInstancesPolicy,
+	}
+
+	id, err := startInstanceRefresh(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("rolling back Auto Scaling Group (%s) instance refresh: %w", name, err)
+	}
+
+	if _, err := waitInstanceRefreshSuccessful(ctx, conn, name, id, instanceRefreshRolledBackTimeout); err != nil {
+		return fmt.Errorf("waiting for Auto Scaling Group (%s) instance refresh (%s) to previous configuration: %w", name, id, err)
+	}
+
+	return nil
+}
+
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.StringValue(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefreshWithContext(ctx, input)
 		},
@@ -4063,10 +4264,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, in
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.StringValue(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
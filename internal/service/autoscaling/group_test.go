@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -1366,6 +1367,80 @@ func TestAccAutoScalingGroup_largeDesiredCapacity(t *testing.T) {
 	})
 }
 
+func TestInstanceRefreshFailureAction(t *testing.T) {
+	t.Parallel()
+
+	refresh := func(status string) *autoscaling.InstanceRefresh {
+		return &autoscaling.InstanceRefresh{
+			Status:       aws.String(status),
+			StatusReason: aws.String("reason"),
+		}
+	}
+	timedOut := &retry.TimeoutError{LastError: errors.New("reason")}
+	unexpectedState := &retry.UnexpectedStateError{}
+
+	testCases := map[string]struct {
+		onFailure string
+		refresh   *autoscaling.InstanceRefresh
+		err       error
+		want      string
+	}{
+		"succeeded": {
+			onFailure: "rollback",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusSuccessful),
+		},
+		"timed out no action": {
+			refresh: refresh(autoscaling.InstanceRefreshStatusInProgress),
+			err:     timedOut,
+		},
+		"timed out cancel": {
+			onFailure: "cancel",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusInProgress),
+			err:       timedOut,
+			want:      "cancel",
+		},
+		"timed out rollback": {
+			onFailure: "rollback",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusInProgress),
+			err:       timedOut,
+			want:      "rollback",
+		},
+		"failed cancel": {
+			onFailure: "cancel",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusFailed),
+			err:       unexpectedState,
+		},
+		"failed rollback": {
+			onFailure: "rollback",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusFailed),
+			err:       unexpectedState,
+			want:      "refresh-previous",
+		},
+		"cancelled rollback": {
+			onFailure: "rollback",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusCancelled),
+			err:       unexpectedState,
+			want:      "refresh-previous",
+		},
+		"rolled back rollback": {
+			onFailure: "rollback",
+			refresh:   refresh(autoscaling.InstanceRefreshStatusRollbackSuccessful),
+			err:       unexpectedState,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfautoscaling.InstanceRefreshFailureAction(testCase.onFailure, testCase.refresh, testCase.err); got != testCase.want {
+				t.Errorf("InstanceRefreshFailureAction() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group autoscaling.Group
@@ -1602,6 +1677,40 @@ func TestAccAutoScalingGroup_InstanceRefresh_triggers(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_InstanceRefresh_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group autoscaling.Group
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_instanceRefreshWaitForCompletion(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.on_failure", "cancel"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.wait_for_completion", "true"),
+					testAccCheckInstanceRefreshCount(ctx, &group, 0),
+				),
+			},
+			{
+				Config: testAccGroupConfig_instanceRefreshWaitForCompletion(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_autoRollback(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group autoscaling.Group
@@ -5271,6 +5380,43 @@ resource "aws_autoscaling_group" "test" {
 `, rName))
 }
 
+func testAccGroupConfig_instanceRefreshWaitForCompletion(rName, instanceType string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, instanceType), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  name               = %[1]q
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+
+  instance_refresh {
+    strategy            = "Rolling"
+    wait_for_completion = true
+    on_failure          = "cancel"
+
+    preferences {
+      min_healthy_percentage = 0
+    }
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+
+  timeouts {
+    update = "30m"
+  }
+}
+`, rName))
+}
+
 func testAccGroupConfig_instanceRefreshFull(rName string) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t3.nano"), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
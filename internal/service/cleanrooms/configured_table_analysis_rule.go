@@ -0,0 +1,632 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_analysis_rule")
+func ResourceConfiguredTableAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aggregation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aggregate_columns": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_names": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"function": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregateFunctionName](),
+									},
+								},
+							},
+						},
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"dimension_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"join_required": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.JoinRequiredOption](),
+						},
+						"output_constraints": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"column_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(2),
+									},
+									"type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.AggregationType](),
+									},
+								},
+							},
+						},
+						"scalar_functions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.ScalarFunctions](),
+							},
+						},
+					},
+				},
+			},
+			"analysis_rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ConfiguredTableAnalysisRuleType](),
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_analyses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_analysis_providers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"differential_privacy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"list": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"aggregation", "custom", "list"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_join_operators": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.JoinOperator](),
+							},
+						},
+						"join_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"list_columns": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameConfiguredTableAnalysisRule = "Configured Table Analysis Rule"
+
+	configuredTableAnalysisRuleIDPartCount = 2
+)
+
+func resourceConfiguredTableAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	configuredTableID := d.Get("configured_table_id").(string)
+	ruleType := types.ConfiguredTableAnalysisRuleType(d.Get("analysis_rule_type").(string))
+
+	policy, err := expandConfiguredTableAnalysisRulePolicy(d, ruleType)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{configuredTableID, string(ruleType)}, configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAnalysisRule, configuredTableID, err)
+	}
+	d.SetId(id)
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	out, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, parts[0], types.ConfiguredTableAnalysisRuleType(parts[1]))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	rule := out.AnalysisRule
+	d.Set("analysis_rule_type", rule.Type)
+	d.Set("configured_table_arn", rule.ConfiguredTableArn)
+	d.Set("configured_table_id", rule.ConfiguredTableId)
+	d.Set("create_time", rule.CreateTime.String())
+	d.Set("update_time", rule.UpdateTime.String())
+
+	var aggregation, custom, list []interface{}
+	if v, ok := rule.Policy.(*types.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			aggregation = flattenAnalysisRuleAggregation(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+			custom = flattenAnalysisRuleCustom(&v.Value)
+		case *types.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			list = flattenAnalysisRuleList(&v.Value)
+		}
+	}
+
+	if err := d.Set("aggregation", aggregation); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting aggregation: %s", err)
+	}
+	if err := d.Set("custom", custom); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting custom: %s", err)
+	}
+	if err := d.Set("list", list); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting list: %s", err)
+	}
+
+	return diags
+}
+
+func resourceConfiguredTableAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	ruleType := types.ConfiguredTableAnalysisRuleType(parts[1])
+	policy, err := expandConfiguredTableAnalysisRulePolicy(d, ruleType)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(parts[0]),
+	}
+
+	_, err = conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return append(diags, resourceConfiguredTableAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Analysis Rule %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(parts[1]),
+		ConfiguredTableIdentifier: aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAnalysisRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, ruleType types.ConfiguredTableAnalysisRuleType) (*cleanrooms.GetConfiguredTableAnalysisRuleOutput, error) {
+	in := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          ruleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	out, err := conn.GetConfiguredTableAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandConfiguredTableAnalysisRulePolicy(d *schema.ResourceData, ruleType types.ConfiguredTableAnalysisRuleType) (types.ConfiguredTableAnalysisRulePolicy, error) {
+	var policy types.ConfiguredTableAnalysisRulePolicyV1
+
+	switch ruleType {
+	case types.ConfiguredTableAnalysisRuleTypeAggregation:
+		if v, ok := d.GetOk("aggregation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{
+				Value: expandAnalysisRuleAggregation(v.([]interface{})[0].(map[string]interface{})),
+			}
+		}
+	case types.ConfiguredTableAnalysisRuleTypeCustom:
+		if v, ok := d.GetOk("custom"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberCustom{
+				Value: expandAnalysisRuleCustom(v.([]interface{})[0].(map[string]interface{})),
+			}
+		}
+	case types.ConfiguredTableAnalysisRuleTypeList:
+		if v, ok := d.GetOk("list"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			policy = &types.ConfiguredTableAnalysisRulePolicyV1MemberList{
+				Value: expandAnalysisRuleList(v.([]interface{})[0].(map[string]interface{})),
+			}
+		}
+	}
+
+	if policy == nil {
+		return nil, fmt.Errorf("analysis_rule_type %s requires a matching %q configuration block", ruleType, analysisRuleTypeBlockName(ruleType))
+	}
+
+	return &types.ConfiguredTableAnalysisRulePolicyMemberV1{
+		Value: policy,
+	}, nil
+}
+
+func analysisRuleTypeBlockName(ruleType types.ConfiguredTableAnalysisRuleType) string {
+	switch ruleType {
+	case types.ConfiguredTableAnalysisRuleTypeAggregation:
+		return "aggregation"
+	case types.ConfiguredTableAnalysisRuleTypeCustom:
+		return "custom"
+	case types.ConfiguredTableAnalysisRuleTypeList:
+		return "list"
+	default:
+		return string(ruleType)
+	}
+}
+
+func expandAnalysisRuleAggregation(tfMap map[string]interface{}) types.AnalysisRuleAggregation {
+	apiObject := types.AnalysisRuleAggregation{
+		DimensionColumns: flex.ExpandStringValueSet(tfMap["dimension_columns"].(*schema.Set)),
+		JoinColumns:      flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ScalarFunctions:  flex.ExpandStringyValueSet[types.ScalarFunctions](tfMap["scalar_functions"].(*schema.Set)),
+	}
+
+	for _, v := range tfMap["aggregate_columns"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		column := v.(map[string]interface{})
+		apiObject.AggregateColumns = append(apiObject.AggregateColumns, types.AggregateColumn{
+			ColumnNames: flex.ExpandStringValueSet(column["column_names"].(*schema.Set)),
+			Function:    types.AggregateFunctionName(column["function"].(string)),
+		})
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	if v, ok := tfMap["join_required"].(string); ok && v != "" {
+		apiObject.JoinRequired = types.JoinRequiredOption(v)
+	}
+
+	for _, v := range tfMap["output_constraints"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+		constraint := v.(map[string]interface{})
+		apiObject.OutputConstraints = append(apiObject.OutputConstraints, types.AggregationConstraint{
+			ColumnName: aws.String(constraint["column_name"].(string)),
+			Minimum:    aws.Int32(int32(constraint["minimum"].(int))),
+			Type:       types.AggregationType(constraint["type"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleCustom(tfMap map[string]interface{}) types.AnalysisRuleCustom {
+	apiObject := types.AnalysisRuleCustom{
+		AllowedAnalyses: flex.ExpandStringValueSet(tfMap["allowed_analyses"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_analysis_providers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedAnalysisProviders = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["differential_privacy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		differentialPrivacy := &types.DifferentialPrivacyConfiguration{}
+
+		for _, v := range v[0].(map[string]interface{})["columns"].([]interface{}) {
+			if v == nil {
+				continue
+			}
+			differentialPrivacy.Columns = append(differentialPrivacy.Columns, types.DifferentialPrivacyColumn{
+				Name: aws.String(v.(map[string]interface{})[names.AttrName].(string)),
+			})
+		}
+
+		apiObject.DifferentialPrivacy = differentialPrivacy
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleList(tfMap map[string]interface{}) types.AnalysisRuleList {
+	apiObject := types.AnalysisRuleList{
+		JoinColumns: flex.ExpandStringValueSet(tfMap["join_columns"].(*schema.Set)),
+		ListColumns: flex.ExpandStringValueSet(tfMap["list_columns"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["allowed_join_operators"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedJoinOperators = flex.ExpandStringyValueSet[types.JoinOperator](v)
+	}
+
+	return apiObject
+}
+
+func flattenAnalysisRuleAggregation(apiObject *types.AnalysisRuleAggregation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	aggregateColumns := []interface{}{}
+	for _, v := range apiObject.AggregateColumns {
+		aggregateColumns = append(aggregateColumns, map[string]interface{}{
+			"column_names": v.ColumnNames,
+			"function":     string(v.Function),
+		})
+	}
+
+	outputConstraints := []interface{}{}
+	for _, v := range apiObject.OutputConstraints {
+		outputConstraints = append(outputConstraints, map[string]interface{}{
+			"column_name": aws.ToString(v.ColumnName),
+			"minimum":     int(aws.ToInt32(v.Minimum)),
+			"type":        string(v.Type),
+		})
+	}
+
+	m := map[string]interface{}{
+		"aggregate_columns":      aggregateColumns,
+		"allowed_join_operators": flattenJoinOperators(apiObject.AllowedJoinOperators),
+		"dimension_columns":      apiObject.DimensionColumns,
+		"join_columns":           apiObject.JoinColumns,
+		"join_required":          string(apiObject.JoinRequired),
+		"output_constraints":     outputConstraints,
+		"scalar_functions":       flattenScalarFunctions(apiObject.ScalarFunctions),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAnalysisRuleCustom(apiObject *types.AnalysisRuleCustom) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"allowed_analyses":           apiObject.AllowedAnalyses,
+		"allowed_analysis_providers": apiObject.AllowedAnalysisProviders,
+	}
+
+	if v := apiObject.DifferentialPrivacy; v != nil {
+		columns := []interface{}{}
+		for _, v := range v.Columns {
+			columns = append(columns, map[string]interface{}{
+				names.AttrName: aws.ToString(v.Name),
+			})
+		}
+
+		m["differential_privacy"] = []interface{}{
+			map[string]interface{}{
+				"columns": columns,
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAnalysisRuleList(apiObject *types.AnalysisRuleList) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"allowed_join_operators": flattenJoinOperators(apiObject.AllowedJoinOperators),
+		"join_columns":           apiObject.JoinColumns,
+		"list_columns":           apiObject.ListColumns,
+	}
+
+	return []interface{}{m}
+}
+
+func flattenJoinOperators(operators []types.JoinOperator) []string {
+	flattenedOperators := []string{}
+	for _, operator := range operators {
+		flattenedOperators = append(flattenedOperators, string(operator))
+	}
+	return flattenedOperators
+}
+
+func flattenScalarFunctions(functions []types.ScalarFunctions) []string {
+	flattenedFunctions := []string{}
+	for _, function := range functions {
+		flattenedFunctions = append(flattenedFunctions, string(function))
+	}
+	return flattenedFunctions
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_aggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "AGGREGATION"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.aggregate_columns.0.function", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.dimension_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.join_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.join_required", "QUERY_RUNNER"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.type", "COUNT_DISTINCT"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.scalar_functions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_aggregation(rName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.0.output_constraints.0.minimum", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_list(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.0.join_columns.*", "my_column_1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "list.0.list_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_custom(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_custom(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "custom.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analyses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analyses.0", "ANY_QUERY"),
+					resource.TestCheckResourceAttr(resourceName, "custom.0.allowed_analysis_providers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "list.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysisRule cleanrooms.GetConfiguredTableAnalysisRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			parts := strings.Split(rs.Primary.ID, ",")
+			_, err := conn.GetConfiguredTableAnalysisRule(ctx, &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+				AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(parts[1]),
+				ConfiguredTableIdentifier: aws.String(parts[0]),
+			})
+
+			if err == nil {
+				return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, name string, analysisRule *cleanrooms.GetConfiguredTableAnalysisRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, name, errors.New("not set"))
+		}
+
+		parts := strings.Split(rs.Primary.ID, ",")
+		client := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := client.GetConfiguredTableAnalysisRule(ctx, &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+			AnalysisRuleType:          types.ConfiguredTableAnalysisRuleType(parts[1]),
+			ConfiguredTableIdentifier: aws.String(parts[0]),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAnalysisRule, rs.Primary.ID, err)
+		}
+
+		*analysisRule = *resp
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_aggregation(rName string, minimum int) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "AGGREGATION"
+
+  aggregation {
+    aggregate_columns {
+      column_names = ["my_column_2"]
+      function     = "COUNT_DISTINCT"
+    }
+
+    dimension_columns = ["my_column_2"]
+    join_columns      = ["my_column_1"]
+    join_required     = "QUERY_RUNNER"
+    scalar_functions  = ["TRUNC", "COALESCE"]
+
+    output_constraints {
+      column_name = "my_column_2"
+      minimum     = %[1]d
+      type        = "COUNT_DISTINCT"
+    }
+  }
+}
+`, minimum))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName), `
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  list {
+    join_columns = ["my_column_1"]
+    list_columns = ["my_column_2"]
+  }
+}
+`)
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_custom(rName string) string {
+	return acctest.ConfigCompose(testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "CUSTOM"
+
+  custom {
+    allowed_analyses           = ["ANY_QUERY"]
+    allowed_analysis_providers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`)
+}
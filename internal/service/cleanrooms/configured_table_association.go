@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_association")
+// @Tags(identifierAttribute="arn")
+func ResourceConfiguredTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAssociationCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAssociationRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAssociationUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameConfiguredTableAssociation = "Configured Table Association"
+
+	configuredTableAssociationIDPartCount = 2
+)
+
+func resourceConfiguredTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_id").(string)
+	input := &cleanrooms.CreateConfiguredTableAssociationInput{
+		ConfiguredTableIdentifier: aws.String(d.Get("configured_table_id").(string)),
+		MembershipIdentifier:      aws.String(membershipID),
+		Name:                      aws.String(d.Get(names.AttrName).(string)),
+		RoleArn:                   aws.String(d.Get("role_arn").(string)),
+		Tags:                      getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateConfiguredTableAssociation(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, d.Get(names.AttrName).(string), err)
+	}
+
+	if out == nil || out.ConfiguredTableAssociation == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, d.Get(names.AttrName).(string), errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{membershipID, aws.ToString(out.ConfiguredTableAssociation.Id)}, configuredTableAssociationIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociation, d.Get(names.AttrName).(string), err)
+	}
+	d.SetId(id)
+
+	return append(diags, resourceConfiguredTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAssociationIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociation, d.Id(), err)
+	}
+
+	out, err := findConfiguredTableAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociation, d.Id(), err)
+	}
+
+	association := out.ConfiguredTableAssociation
+	d.Set(names.AttrARN, association.Arn)
+	d.Set("configured_table_arn", association.ConfiguredTableArn)
+	d.Set("configured_table_id", association.ConfiguredTableId)
+	d.Set("create_time", association.CreateTime.String())
+	d.Set(names.AttrDescription, association.Description)
+	d.Set("membership_arn", association.MembershipArn)
+	d.Set("membership_id", association.MembershipId)
+	d.Set(names.AttrName, association.Name)
+	d.Set("role_arn", association.RoleArn)
+	d.Set("update_time", association.UpdateTime.String())
+
+	return diags
+}
+
+func resourceConfiguredTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		parts, err := flex.ExpandResourceId(d.Id(), configuredTableAssociationIDPartCount, false)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAssociation, d.Id(), err)
+		}
+
+		input := &cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: aws.String(parts[1]),
+			MembershipIdentifier:                 aws.String(parts[0]),
+		}
+
+		if d.HasChanges(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges("role_arn") {
+			input.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		_, err = conn.UpdateConfiguredTableAssociation(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAssociation, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfiguredTableAssociationRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAssociationIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAssociation, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Association %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAssociation(ctx, &cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(parts[1]),
+		MembershipIdentifier:                 aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAssociation, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, associationID string) (*cleanrooms.GetConfiguredTableAssociationOutput, error) {
+	in := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	out, err := conn.GetConfiguredTableAssociation(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association cleanrooms.GetConfiguredTableAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &association),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexache.MustCompile(`membership/.+/configuredtableassociation/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_arn", "aws_cleanrooms_membership.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test_association"),
+					resource.TestCheckResourceAttr(resourceName, "description", TEST_DESCRIPTION),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association cleanrooms.GetConfiguredTableAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociation_mutableProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var association cleanrooms.GetConfiguredTableAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, TEST_DESCRIPTION),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationExists(ctx, resourceName, &association),
+				),
+			},
+			{
+				Config: testAccConfiguredTableAssociationConfig_basic(rName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationIsTheSame(resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_association" {
+				continue
+			}
+
+			parts := strings.Split(rs.Primary.ID, ",")
+			_, err := conn.GetConfiguredTableAssociation(ctx, &cleanrooms.GetConfiguredTableAssociationInput{
+				ConfiguredTableAssociationIdentifier: aws.String(parts[1]),
+				MembershipIdentifier:                 aws.String(parts[0]),
+			})
+
+			if err == nil {
+				return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAssociationExists(ctx context.Context, name string, association *cleanrooms.GetConfiguredTableAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, name, errors.New("not set"))
+		}
+
+		parts := strings.Split(rs.Primary.ID, ",")
+		client := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := client.GetConfiguredTableAssociation(ctx, &cleanrooms.GetConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: aws.String(parts[1]),
+			MembershipIdentifier:                 aws.String(parts[0]),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, rs.Primary.ID, err)
+		}
+
+		*association = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAssociationIsTheSame(name string, association *cleanrooms.GetConfiguredTableAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociation, name, errors.New("not found"))
+		}
+
+		if id := aws.ToString(association.ConfiguredTableAssociation.Id); !strings.HasSuffix(rs.Primary.ID, ","+id) {
+			return fmt.Errorf("New configured table association: %s created instead of updating: %s", rs.Primary.ID, id)
+		}
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAssociationConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig_basic(TEST_NAME, TEST_DESCRIPTION, TEST_TAG, rName),
+		testAccMembershipConfig_basic(rName, "DISABLED"),
+		fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "cleanrooms.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_cleanrooms_configured_table_association" "test" {
+  name                = "test_association"
+  description         = %[2]q
+  membership_id       = aws_cleanrooms_membership.test.id
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  role_arn            = aws_iam_role.test.arn
+
+  tags = {
+    Project = %[3]q
+  }
+}
+`, rName, description, TEST_TAG))
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cleanrooms", regexache.MustCompile(`membership/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_id", "aws_cleanrooms_collaboration.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "collaboration_arn", "aws_cleanrooms_collaboration.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "collaboration_creator_display_name", TEST_CREATOR_DISPLAY_NAME),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "member_abilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Project", TEST_TAG),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsMembership_mutableProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var membership cleanrooms.GetMembershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembershipConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipExists(ctx, resourceName, &membership),
+				),
+			},
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "ENABLED", "CSV"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipIsTheSame(resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "query_log_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_result_configuration.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.key_prefix", "results/"),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "CSV"),
+				),
+			},
+			{
+				Config: testAccMembershipConfig_defaultResultConfiguration(rName, "ENABLED", "PARQUET"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembershipIsTheSame(resourceName, &membership),
+					resource.TestCheckResourceAttr(resourceName, "default_result_configuration.0.output_configuration.0.s3.0.result_format", "PARQUET"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_membership" {
+				continue
+			}
+
+			out, err := conn.GetMembership(ctx, &cleanrooms.GetMembershipInput{
+				MembershipIdentifier: aws.String(rs.Primary.ID),
+			})
+
+			if err == nil && out.Membership.Status == types.MembershipStatusActive {
+				return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, rs.Primary.ID, errors.New("not destroyed"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipExists(ctx context.Context, name string, membership *cleanrooms.GetMembershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not set"))
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := client.GetMembership(ctx, &cleanrooms.GetMembershipInput{
+			MembershipIdentifier: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, rs.Primary.ID, err)
+		}
+
+		*membership = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckMembershipIsTheSame(name string, membership *cleanrooms.GetMembershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameMembership, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID != aws.ToString(membership.Membership.Id) {
+			return fmt.Errorf("New membership: %s created instead of updating: %s", rs.Primary.ID, aws.ToString(membership.Membership.Id))
+		}
+
+		return nil
+	}
+}
+
+func testAccMembershipConfig_collaboration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_collaboration" "test" {
+  name                     = %[1]q
+  creator_member_abilities = ["CAN_QUERY", "CAN_RECEIVE_RESULTS"]
+  creator_display_name     = %[2]q
+  description              = %[1]q
+  query_log_status         = "ENABLED"
+}
+`, rName, TEST_CREATOR_DISPLAY_NAME)
+}
+
+func testAccMembershipConfig_basic(rName, queryLogStatus string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_collaboration(rName), fmt.Sprintf(`
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[1]q
+
+  tags = {
+    Project = %[2]q
+  }
+}
+`, queryLogStatus, TEST_TAG))
+}
+
+func testAccMembershipConfig_defaultResultConfiguration(rName, queryLogStatus, resultFormat string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_collaboration(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "cleanrooms.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_cleanrooms_membership" "test" {
+  collaboration_id = aws_cleanrooms_collaboration.test.id
+  query_log_status = %[2]q
+
+  default_result_configuration {
+    role_arn = aws_iam_role.test.arn
+
+    output_configuration {
+      s3 {
+        bucket        = aws_s3_bucket.test.bucket
+        key_prefix    = "results/"
+        result_format = %[3]q
+      }
+    }
+  }
+
+  tags = {
+    Project = %[4]q
+  }
+}
+`, rName, queryLogStatus, resultFormat, TEST_TAG))
+}
@@ -11,6 +11,11 @@ const (
 	ResNameOriginAccessIdentity = "Origin Access Identity"
 )
 
+const (
+	keyValueStoreStatusProvisioning = "PROVISIONING"
+	keyValueStoreStatusReady        = "READY"
+)
+
 func StreamType_Values() []string {
 	return []string{
 		StreamTypeKinesis,
@@ -6,6 +6,8 @@ package cloudfront
 // Exports for use in tests only.
 var (
 	ResourceContinuousDeploymentPolicy = newResourceContinuousDeploymentPolicy
+	ResourceKeyValueStore              = resourceKeyValueStore
 
-	FindPublicKeyByID = findPublicKeyByID
+	FindKeyValueStoreByName = findKeyValueStoreByName
+	FindPublicKeyByID       = findPublicKeyByID
 )
@@ -289,6 +289,44 @@ func TestAccCloudFrontFunction_Update_comment(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_keyValueStoreAssociations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_keyValueStoreAssociations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "key_value_store_associations.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_value_store_associations.*", "aws_cloudfront_key_value_store.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "runtime", "cloudfront-js-2.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish"},
+			},
+			{
+				Config: testAccFunctionConfig_keyValueStoreAssociationsRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "key_value_store_associations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
@@ -576,3 +614,50 @@ EOT
 }
 `, rName, comment)
 }
+
+func testAccFunctionConfig_keyValueStoreAssociations(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+import cf from 'cloudfront';
+
+const kvsHandle = cf.kvs();
+
+async function handler(event) {
+	const value = await kvsHandle.get('redirect', { format: 'string' });
+	return {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: { 'location': { value: value } }
+	};
+}
+EOT
+
+  key_value_store_associations = [aws_cloudfront_key_value_store.test.arn]
+}
+`, rName)
+}
+
+func testAccFunctionConfig_keyValueStoreAssociationsRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+function handler(event) {
+	return event.request;
+}
+EOT
+}
+`, rName)
+}
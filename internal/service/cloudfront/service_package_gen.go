@@ -99,6 +99,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceKeyGroup,
 			TypeName: "aws_cloudfront_key_group",
 		},
+		{
+			Factory:  resourceKeyValueStore,
+			TypeName: "aws_cloudfront_key_value_store",
+			Name:     "Key Value Store",
+		},
 		{
 			Factory:  ResourceMonitoringSubscription,
 			TypeName: "aws_cloudfront_monitoring_subscription",
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontkeyvaluestore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Key")
+func newKeyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &keyResource{}, nil
+}
+
+const (
+	ResNameKey = "Key"
+
+	// Writes to a key value store are serialized using its ETag; concurrent writers must retry.
+	keyWriteTimeout = 2 * time.Minute
+)
+
+type keyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *keyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfrontkeyvaluestore_key"
+}
+
+func (r *keyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_value_store_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed: true,
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *keyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	output, err := putKey(ctx, conn, data.KvsARN.ValueString(), data.Key.ValueString(), data.Value.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionCreating, ResNameKey, data.Key.ValueString(), err), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *keyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data keyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	output, err := findKeyByTwoPartKey(ctx, conn, data.KvsARN.ValueString(), data.Key.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionReading, ResNameKey, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	data.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+	data.Value = fwflex.StringToFramework(ctx, output.Value)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *keyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new keyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	output, err := putKey(ctx, conn, new.KvsARN.ValueString(), new.Key.ValueString(), new.Value.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionUpdating, ResNameKey, new.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	new.TotalSizeInBytes = fwflex.Int64ToFramework(ctx, output.TotalSizeInBytes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *keyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data keyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontKeyValueStoreClient(ctx)
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, keyWriteTimeout, func() (interface{}, error) {
+		etag, err := findETagByARN(ctx, conn, data.KvsARN.ValueString())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return conn.DeleteKey(ctx, &cloudfrontkeyvaluestore.DeleteKeyInput{
+			IfMatch: etag,
+			Key:     aws.String(data.Key.ValueString()),
+			KvsARN:  aws.String(data.KvsARN.ValueString()),
+		})
+	})
+
+	if tfresource.NotFound(err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.CloudFrontKeyValueStore, create.ErrActionDeleting, ResNameKey, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+}
+
+// putKey writes a single key, retrying when another writer has changed the key value store's ETag.
+func putKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key, value string) (*cloudfrontkeyvaluestore.PutKeyOutput, error) {
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, keyWriteTimeout, func() (interface{}, error) {
+		etag, err := findETagByARN(ctx, conn, kvsARN)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return conn.PutKey(ctx, &cloudfrontkeyvaluestore.PutKeyInput{
+			IfMatch: etag,
+			Key:     aws.String(key),
+			KvsARN:  aws.String(kvsARN),
+			Value:   aws.String(value),
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*cloudfrontkeyvaluestore.PutKeyOutput), nil
+}
+
+func findETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, arn string) (*string, error) {
+	input := &cloudfrontkeyvaluestore.DescribeKeyValueStoreInput{
+		KvsARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeKeyValueStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ETag == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ETag, nil
+}
+
+func findKeyByTwoPartKey(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, kvsARN, key string) (*cloudfrontkeyvaluestore.GetKeyOutput, error) {
+	input := &cloudfrontkeyvaluestore.GetKeyInput{
+		Key:    aws.String(key),
+		KvsARN: aws.String(kvsARN),
+	}
+
+	output, err := conn.GetKey(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type keyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Key              types.String `tfsdk:"key"`
+	KvsARN           fwtypes.ARN  `tfsdk:"key_value_store_arn"`
+	TotalSizeInBytes types.Int64  `tfsdk:"total_size_in_bytes"`
+	Value            types.String `tfsdk:"value"`
+}
+
+func (data *keyResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	// Keys can contain the separator but Key Value Store ARNs can't, so only split on its first occurrence.
+	kvsARN, key, found := strings.Cut(id, flex.ResourceIdSeparator)
+
+	if !found || kvsARN == "" || key == "" {
+		return fmt.Errorf("unexpected format for ID (%[1]s), expected KEY_VALUE_STORE_ARN%[2]sKEY", id, flex.ResourceIdSeparator)
+	}
+
+	data.KvsARN = fwtypes.ARNValue(kvsARN)
+	data.Key = types.StringValue(key)
+
+	return nil
+}
+
+func (data *keyResourceModel) setID() {
+	data.ID = types.StringValue(data.KvsARN.ValueString() + flex.ResourceIdSeparator + data.Key.ValueString())
+}
@@ -4,6 +4,8 @@
 package cognitoidp
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -264,3 +266,395 @@ func TestSkipFlatteningStringAttributeContraints(t *testing.T) {
 		})
 	}
 }
+
+func TestUserPoolSchemaAttributesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a    *cognitoidentityprovider.SchemaAttributeType
+		b    *cognitoidentityprovider.SchemaAttributeType
+		want bool
+	}{
+		{
+			name: "custom prefix",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+				DeveloperOnlyAttribute: aws.Bool(true),
+				Mutable:                aws.Bool(false),
+				Name:                   aws.String("mybool"),
+				Required:               aws.Bool(false),
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+				DeveloperOnlyAttribute: aws.Bool(true),
+				Mutable:                aws.Bool(false),
+				Name:                   aws.String("dev:custom:mybool"),
+				Required:               aws.Bool(false),
+			},
+			want: true,
+		},
+		{
+			name: "string constraints omitted",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("mystring"),
+				Required:               aws.Bool(false),
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("custom:mystring"),
+				Required:               aws.Bool(false),
+				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					MaxLength: aws.String("2048"),
+					MinLength: aws.String("0"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "string constraints partially set",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("mystring"),
+				Required:               aws.Bool(false),
+				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					MaxLength: aws.String("256"),
+				},
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("custom:mystring"),
+				Required:               aws.Bool(false),
+				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					MaxLength: aws.String("256"),
+					MinLength: aws.String("0"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "string constraints differ",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("mystring"),
+				Required:               aws.Bool(false),
+				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					MaxLength: aws.String("256"),
+				},
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("custom:mystring"),
+				Required:               aws.Bool(false),
+				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					MaxLength: aws.String("2048"),
+					MinLength: aws.String("0"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "number constraints empty",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:          aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
+				DeveloperOnlyAttribute:     aws.Bool(false),
+				Mutable:                    aws.Bool(true),
+				Name:                       aws.String("mynumber"),
+				NumberAttributeConstraints: &cognitoidentityprovider.NumberAttributeConstraintsType{},
+				Required:                   aws.Bool(false),
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("custom:mynumber"),
+				Required:               aws.Bool(false),
+			},
+			want: true,
+		},
+		{
+			name: "developer only prefix",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+				DeveloperOnlyAttribute: aws.Bool(true),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("mybool"),
+				Required:               aws.Bool(false),
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+				DeveloperOnlyAttribute: aws.Bool(true),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("dev:custom:mybool"),
+				Required:               aws.Bool(false),
+			},
+			want: true,
+		},
+		{
+			name: "custom attribute named as standard attribute",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("email"),
+				Required:               aws.Bool(false),
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("custom:email"),
+				Required:               aws.Bool(false),
+			},
+			want: false,
+		},
+		{
+			name: "mutable differs",
+			a: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(false),
+				Name:                   aws.String("mybool"),
+				Required:               aws.Bool(false),
+			},
+			b: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("custom:mybool"),
+				Required:               aws.Bool(false),
+			},
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := userPoolSchemaAttributesEquivalent(tc.a, tc.b)
+			if got != tc.want {
+				t.Fatalf("userPoolSchemaAttributesEquivalent() got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUserPoolSchemaAttributesToAdd(t *testing.T) {
+	t.Parallel()
+
+	email := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(false),
+		Name:                   aws.String("email"),
+		Required:               aws.Bool(true),
+	}
+	emailDefaultConstraints := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(false),
+		Name:                   aws.String("email"),
+		Required:               aws.Bool(true),
+		StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+			MaxLength: aws.String("2048"),
+			MinLength: aws.String("0"),
+		},
+	}
+	emailConstrained := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(false),
+		Name:                   aws.String("email"),
+		Required:               aws.Bool(true),
+		StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+			MaxLength: aws.String("10"),
+			MinLength: aws.String("5"),
+		},
+	}
+	myBool := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+		DeveloperOnlyAttribute: aws.Bool(true),
+		Mutable:                aws.Bool(false),
+		Name:                   aws.String("mybool"),
+		Required:               aws.Bool(false),
+	}
+	myBoolMutable := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+		DeveloperOnlyAttribute: aws.Bool(true),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("mybool"),
+		Required:               aws.Bool(false),
+	}
+	myBoolNotDeveloperOnly := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(false),
+		Name:                   aws.String("mybool"),
+		Required:               aws.Bool(false),
+	}
+	myString := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("mystring"),
+		Required:               aws.Bool(false),
+	}
+	myStringConstrained := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("mystring"),
+		Required:               aws.Bool(false),
+		StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+			MaxLength: aws.String("256"),
+		},
+	}
+	myNumber := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("mynumber"),
+		Required:               aws.Bool(false),
+	}
+	myNumberRequired := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("mynumber"),
+		Required:               aws.Bool(true),
+	}
+	address := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("address"),
+		Required:               aws.Bool(false),
+	}
+	addressRequired := &cognitoidentityprovider.SchemaAttributeType{
+		AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+		DeveloperOnlyAttribute: aws.Bool(false),
+		Mutable:                aws.Bool(true),
+		Name:                   aws.String("address"),
+		Required:               aws.Bool(true),
+	}
+
+	cases := []struct {
+		name    string
+		old     []*cognitoidentityprovider.SchemaAttributeType
+		new     []*cognitoidentityprovider.SchemaAttributeType
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "no change",
+			old:  []*cognitoidentityprovider.SchemaAttributeType{email, myBool},
+			new:  []*cognitoidentityprovider.SchemaAttributeType{email, myBool},
+		},
+		{
+			name: "custom attribute added",
+			old:  []*cognitoidentityprovider.SchemaAttributeType{email, myBool},
+			new:  []*cognitoidentityprovider.SchemaAttributeType{email, myBool, myNumber},
+			want: []string{"mynumber"},
+		},
+		{
+			name: "default constraints set",
+			old:  []*cognitoidentityprovider.SchemaAttributeType{email},
+			new:  []*cognitoidentityprovider.SchemaAttributeType{emailDefaultConstraints},
+		},
+		{
+			name:    "non-default constraints set",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{email},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{emailConstrained},
+			wantErr: "schema attribute (email) cannot be modified",
+		},
+		{
+			name:    "constraints omitted",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{emailConstrained},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{email},
+			wantErr: "schema attribute (email) cannot be modified",
+		},
+		{
+			name:    "custom attribute constraints set",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{myString},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{myStringConstrained},
+			wantErr: "schema attribute (mystring) cannot be modified",
+		},
+		{
+			name:    "developer only attribute changed",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{myBool},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{myBoolNotDeveloperOnly},
+			wantErr: "schema attribute (mybool) cannot be removed",
+		},
+		{
+			name:    "custom attribute modified",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{myBool},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{myBoolMutable},
+			wantErr: "schema attribute (mybool) cannot be modified",
+		},
+		{
+			name:    "custom attribute removed",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{email, myBool},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{email},
+			wantErr: "schema attribute (mybool) cannot be removed",
+		},
+		{
+			name:    "required custom attribute added",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{email},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{email, myNumberRequired},
+			wantErr: "custom schema attribute (mynumber) cannot be required",
+		},
+		{
+			name: "standard attribute with defaults added",
+			old:  []*cognitoidentityprovider.SchemaAttributeType{email},
+			new:  []*cognitoidentityprovider.SchemaAttributeType{email, address},
+		},
+		{
+			name:    "standard attribute without defaults added",
+			old:     []*cognitoidentityprovider.SchemaAttributeType{email},
+			new:     []*cognitoidentityprovider.SchemaAttributeType{email, addressRequired},
+			wantErr: "standard schema attribute (address) can only be configured when the user pool is created",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			output, err := userPoolSchemaAttributesToAdd(tc.old, tc.new)
+
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("userPoolSchemaAttributesToAdd() got error %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("userPoolSchemaAttributesToAdd() got unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, v := range output {
+				got = append(got, aws.StringValue(v.Name))
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("userPoolSchemaAttributesToAdd() got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
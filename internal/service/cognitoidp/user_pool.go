@@ -5,6 +5,7 @@ package cognitoidp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -614,7 +616,10 @@ func ResourceUserPool() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffUserPoolSchema,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -1215,18 +1220,24 @@ func resourceUserPoolUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if d.HasChange("schema") {
-		oldSchema, newSchema := d.GetChange("schema")
-		if oldSchema.(*schema.Set).Difference(newSchema.(*schema.Set)).Len() == 0 {
-			params := &cognitoidentityprovider.AddCustomAttributesInput{
+		o, n := d.GetChange("schema")
+		customAttributes, err := userPoolSchemaAttributesToAdd(expandUserPoolSchema(o.(*schema.Set).List()), expandUserPoolSchema(n.(*schema.Set).List()))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s) schema: %s", d.Id(), err)
+		}
+
+		if len(customAttributes) > 0 {
+			input := &cognitoidentityprovider.AddCustomAttributesInput{
+				CustomAttributes: customAttributes,
 				UserPoolId:       aws.String(d.Id()),
-				CustomAttributes: expandUserPoolSchema(newSchema.(*schema.Set).Difference(oldSchema.(*schema.Set)).List()),
 			}
-			_, err := conn.AddCustomAttributesWithContext(ctx, params)
+
+			_, err := conn.AddCustomAttributesWithContext(ctx, input)
+
 			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): unable to add custom attributes from schema: %s", d.Id(), err)
+				return sdkdiag.AppendErrorf(diags, "adding Cognito User Pool (%s) custom attributes: %s", d.Id(), err)
 			}
-		} else {
-			return sdkdiag.AppendErrorf(diags, "updating Cognito User Pool (%s): cannot modify or remove schema items", d.Id())
 		}
 	}
 
@@ -1768,8 +1779,12 @@ func flattenUserPoolSchema(configuredAttributes, inputs []*cognitoidentityprovid
 		configured := false
 
 		for _, configuredAttribute := range configuredAttributes {
-			if reflect.DeepEqual(input, configuredAttribute) {
+			if userPoolSchemaAttributesEquivalent(input, configuredAttribute) {
+				// Use the configured value so that attribute constraints defaulted by the API
+				// do not cause a perpetual difference.
+				input = configuredAttribute
 				configured = true
+				break
 			}
 		}
 
@@ -1982,6 +1997,15 @@ func UserPoolSchemaAttributeMatchesStandardAttribute(input *cognitoidentityprovi
 		return false
 	}
 
+	for _, standardAttribute := range userPoolStandardAttributes() {
+		if reflect.DeepEqual(*input, standardAttribute) {
+			return true
+		}
+	}
+	return false
+}
+
+func userPoolStandardAttributes() []cognitoidentityprovider.SchemaAttributeType {
 	// All standard attributes always returned by API
 	// https://docs.aws.amazon.com/cognito/latest/developerguide/user-pool-settings-attributes.html#cognito-user-pools-standard-attributes
 	var standardAttributes = []cognitoidentityprovider.SchemaAttributeType{
@@ -2197,12 +2221,7 @@ func UserPoolSchemaAttributeMatchesStandardAttribute(input *cognitoidentityprovi
 			},
 		},
 	}
-	for _, standardAttribute := range standardAttributes {
-		if reflect.DeepEqual(*input, standardAttribute) {
-			return true
-		}
-	}
-	return false
+	return standardAttributes
 }
 
 func expandUserPoolCustomSMSSender(config map[string]interface{}) *cognitoidentityprovider.CustomSMSLambdaVersionConfigType {
@@ -2323,3 +2342,214 @@ func skipFlatteningStringAttributeContraints(configuredAttributes []*cognitoiden
 	}
 	return skip
 }
+
+func customizeDiffUserPoolSchema(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("schema") || !diff.NewValueKnown("schema") {
+		return nil
+	}
+
+	o, n := diff.GetChange("schema")
+	_, err := userPoolSchemaAttributesToAdd(expandUserPoolSchema(o.(*schema.Set).List()), expandUserPoolSchema(n.(*schema.Set).List()))
+
+	return err
+}
+
+// userPoolSchemaAttributesToAdd returns the custom attributes that must be added to a user pool
+// to change its schema from `old` to `new`.
+// An error is returned if the change cannot be made in-place, as existing attributes
+// cannot be modified or removed and standard attributes can only be configured at creation.
+func userPoolSchemaAttributesToAdd(old, new []*cognitoidentityprovider.SchemaAttributeType) ([]*cognitoidentityprovider.SchemaAttributeType, error) {
+	var errs []error
+
+	for _, o := range old {
+		name := userPoolSchemaAttributeName(o)
+		n := findUserPoolSchemaAttributeByFullName(new, userPoolSchemaAttributeFullName(o))
+
+		if n == nil {
+			errs = append(errs, fmt.Errorf("schema attribute (%s) cannot be removed", name))
+			continue
+		}
+
+		if !userPoolSchemaAttributesEquivalent(o, n) {
+			errs = append(errs, fmt.Errorf("schema attribute (%s) cannot be modified", name))
+		}
+	}
+
+	var add []*cognitoidentityprovider.SchemaAttributeType
+
+	for _, n := range new {
+		name := userPoolSchemaAttributeName(n)
+
+		if findUserPoolSchemaAttributeByFullName(old, userPoolSchemaAttributeFullName(n)) != nil {
+			continue
+		}
+
+		if v := findUserPoolStandardAttributeByName(userPoolSchemaAttributeFullName(n)); v != nil {
+			// Configuring a standard attribute with its default settings is a no-op.
+			if !userPoolSchemaAttributesEquivalent(v, n) {
+				errs = append(errs, fmt.Errorf("standard schema attribute (%s) can only be configured when the user pool is created", name))
+			}
+			continue
+		}
+
+		if aws.BoolValue(n.Required) {
+			errs = append(errs, fmt.Errorf("custom schema attribute (%s) cannot be required", name))
+			continue
+		}
+
+		add = append(add, n)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return add, nil
+}
+
+// userPoolSchemaAttributesEquivalent returns whether two schema attributes are equivalent.
+// Attribute names are compared including any "dev:" or "custom:" prefix implied by the attribute's settings and
+// unset attribute constraint values are equivalent to the API's default values for the attribute.
+func userPoolSchemaAttributesEquivalent(a, b *cognitoidentityprovider.SchemaAttributeType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	a = userPoolSchemaAttributeWithDefaults(a, userPoolSchemaAttributeDefaults(a))
+	b = userPoolSchemaAttributeWithDefaults(b, userPoolSchemaAttributeDefaults(b))
+
+	if userPoolSchemaAttributeFullName(a) != userPoolSchemaAttributeFullName(b) ||
+		aws.StringValue(a.AttributeDataType) != aws.StringValue(b.AttributeDataType) ||
+		aws.BoolValue(a.DeveloperOnlyAttribute) != aws.BoolValue(b.DeveloperOnlyAttribute) ||
+		aws.BoolValue(a.Mutable) != aws.BoolValue(b.Mutable) ||
+		aws.BoolValue(a.Required) != aws.BoolValue(b.Required) {
+		return false
+	}
+
+	switch aws.StringValue(a.AttributeDataType) {
+	case cognitoidentityprovider.AttributeDataTypeNumber:
+		var x, y cognitoidentityprovider.NumberAttributeConstraintsType
+		if a.NumberAttributeConstraints != nil {
+			x = *a.NumberAttributeConstraints
+		}
+		if b.NumberAttributeConstraints != nil {
+			y = *b.NumberAttributeConstraints
+		}
+
+		return userPoolSchemaAttributeConstraintEquivalent(x.MinValue, y.MinValue) &&
+			userPoolSchemaAttributeConstraintEquivalent(x.MaxValue, y.MaxValue)
+	case cognitoidentityprovider.AttributeDataTypeString:
+		var x, y cognitoidentityprovider.StringAttributeConstraintsType
+		if a.StringAttributeConstraints != nil {
+			x = *a.StringAttributeConstraints
+		}
+		if b.StringAttributeConstraints != nil {
+			y = *b.StringAttributeConstraints
+		}
+
+		return userPoolSchemaAttributeConstraintEquivalent(x.MinLength, y.MinLength) &&
+			userPoolSchemaAttributeConstraintEquivalent(x.MaxLength, y.MaxLength)
+	}
+
+	return true
+}
+
+// userPoolSchemaAttributeDefaults returns the attribute constraint values that the API uses for the specified attribute
+// when they are not set.
+func userPoolSchemaAttributeDefaults(input *cognitoidentityprovider.SchemaAttributeType) *cognitoidentityprovider.SchemaAttributeType {
+	if v := findUserPoolStandardAttributeByName(userPoolSchemaAttributeFullName(input)); v != nil {
+		return v
+	}
+
+	if aws.StringValue(input.AttributeDataType) == cognitoidentityprovider.AttributeDataTypeString {
+		return &cognitoidentityprovider.SchemaAttributeType{
+			StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				MaxLength: aws.String("2048"),
+				MinLength: aws.String("0"),
+			},
+		}
+	}
+
+	return &cognitoidentityprovider.SchemaAttributeType{}
+}
+
+// userPoolSchemaAttributeWithDefaults returns a copy of `input` with unset attribute constraint values
+// taken from `defaults`.
+func userPoolSchemaAttributeWithDefaults(input, defaults *cognitoidentityprovider.SchemaAttributeType) *cognitoidentityprovider.SchemaAttributeType {
+	output := *input
+
+	if v := defaults.NumberAttributeConstraints; v != nil {
+		var constraints cognitoidentityprovider.NumberAttributeConstraintsType
+		if input.NumberAttributeConstraints != nil {
+			constraints = *input.NumberAttributeConstraints
+		}
+		if aws.StringValue(constraints.MinValue) == "" {
+			constraints.MinValue = v.MinValue
+		}
+		if aws.StringValue(constraints.MaxValue) == "" {
+			constraints.MaxValue = v.MaxValue
+		}
+		output.NumberAttributeConstraints = &constraints
+	}
+
+	if v := defaults.StringAttributeConstraints; v != nil {
+		var constraints cognitoidentityprovider.StringAttributeConstraintsType
+		if input.StringAttributeConstraints != nil {
+			constraints = *input.StringAttributeConstraints
+		}
+		if aws.StringValue(constraints.MinLength) == "" {
+			constraints.MinLength = v.MinLength
+		}
+		if aws.StringValue(constraints.MaxLength) == "" {
+			constraints.MaxLength = v.MaxLength
+		}
+		output.StringAttributeConstraints = &constraints
+	}
+
+	return &output
+}
+
+func userPoolSchemaAttributeConstraintEquivalent(a, b *string) bool {
+	return aws.StringValue(a) == aws.StringValue(b)
+}
+
+func userPoolSchemaAttributeName(input *cognitoidentityprovider.SchemaAttributeType) string {
+	return strings.TrimPrefix(strings.TrimPrefix(aws.StringValue(input.Name), "dev:"), "custom:")
+}
+
+// userPoolSchemaAttributeFullName returns the attribute name as used by the API.
+// Configured custom attributes are named without the "custom:" prefix, and developer only attributes also without the "dev:" prefix.
+func userPoolSchemaAttributeFullName(input *cognitoidentityprovider.SchemaAttributeType) string {
+	name := aws.StringValue(input.Name)
+
+	if strings.HasPrefix(name, "custom:") || strings.HasPrefix(name, "dev:") || findUserPoolStandardAttributeByName(name) != nil {
+		return name
+	}
+
+	if aws.BoolValue(input.DeveloperOnlyAttribute) {
+		return "dev:custom:" + name
+	}
+
+	return "custom:" + name
+}
+
+func findUserPoolSchemaAttributeByFullName(inputs []*cognitoidentityprovider.SchemaAttributeType, name string) *cognitoidentityprovider.SchemaAttributeType {
+	for _, v := range inputs {
+		if v != nil && userPoolSchemaAttributeFullName(v) == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func findUserPoolStandardAttributeByName(name string) *cognitoidentityprovider.SchemaAttributeType {
+	for _, v := range userPoolStandardAttributes() {
+		if aws.StringValue(v.Name) == name {
+			v := v
+			return &v
+		}
+	}
+
+	return nil
+}
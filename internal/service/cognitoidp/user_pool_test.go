@@ -1356,7 +1356,7 @@ func TestAccCognitoIDPUserPool_schemaAttributesRemoved(t *testing.T) {
 			},
 			{
 				Config:      testAccUserPoolConfig_schemaAttributes(rName),
-				ExpectError: regexache.MustCompile("cannot modify or remove schema items"),
+				ExpectError: regexache.MustCompile(`schema attribute \(mynondevnumber\) cannot be removed`),
 			},
 		},
 	})
@@ -1377,7 +1377,7 @@ func TestAccCognitoIDPUserPool_schemaAttributesModified(t *testing.T) {
 			},
 			{
 				Config:      testAccUserPoolConfig_schemaAttributesUpdated(rName, "mybool2"),
-				ExpectError: regexache.MustCompile("cannot modify or remove schema items"),
+				ExpectError: regexache.MustCompile(`schema attribute \(mybool\) cannot be removed`),
 			},
 		},
 	})
@@ -1386,7 +1386,7 @@ func TestAccCognitoIDPUserPool_schemaAttributesModified(t *testing.T) {
 // Ref: https://github.com/hashicorp/terraform-provider-aws/issues/21654
 func TestAccCognitoIDPUserPool_schemaAttributesStringAttributeConstraints(t *testing.T) {
 	ctx := acctest.Context(t)
-	var pool cognitoidentityprovider.DescribeUserPoolOutput
+	var pool1, pool2 cognitoidentityprovider.DescribeUserPoolOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_cognito_user_pool.test"
 
@@ -1401,14 +1401,29 @@ func TestAccCognitoIDPUserPool_schemaAttributesStringAttributeConstraints(t *tes
 				// diff is not present when AWS returns default values in the nested object.
 				Config: testAccUserPoolConfig_schemaAttributesStringAttributeConstraints(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckUserPoolExists(ctx, resourceName, &pool),
+					testAccCheckUserPoolExists(ctx, resourceName, &pool1),
+				),
+			},
+			{
+				// Explicitly setting constraints to the default values after creation
+				// should not trigger an error or recreate the user pool.
+				Config: testAccUserPoolConfig_schemaAttributesStringAttributeConstraintsDefaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPoolExists(ctx, resourceName, &pool2),
+					testAccCheckUserPoolNotRecreated(&pool1, &pool2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "schema.*", map[string]string{
+						"name":                           "email",
+						"string_attribute_constraints.#": "1",
+						"string_attribute_constraints.0.min_length": "0",
+						"string_attribute_constraints.0.max_length": "2048",
+					}),
 				),
 			},
 			{
 				// Attempting to explicitly set constraints to non-default values after creation
-				// should trigger an error
+				// should trigger an error at plan time
 				Config:      testAccUserPoolConfig_schemaAttributes(rName),
-				ExpectError: regexache.MustCompile("cannot modify or remove schema items"),
+				ExpectError: regexache.MustCompile(`schema attribute \(email\) cannot be modified`),
 			},
 		},
 	})
@@ -2513,6 +2528,35 @@ resource "aws_cognito_user_pool" "test" {
 `, name)
 }
 
+func testAccUserPoolConfig_schemaAttributesStringAttributeConstraintsDefaults(name string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = "%[1]s"
+
+  schema {
+    attribute_data_type      = "String"
+    developer_only_attribute = false
+    mutable                  = false
+    name                     = "email"
+    required                 = true
+
+    string_attribute_constraints {
+      min_length = 0
+      max_length = 2048
+    }
+  }
+
+  schema {
+    attribute_data_type      = "Boolean"
+    developer_only_attribute = true
+    mutable                  = false
+    name                     = "mybool"
+    required                 = false
+  }
+}
+`, name)
+}
+
 func testAccUserPoolConfig_verificationMessageTemplate(name string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
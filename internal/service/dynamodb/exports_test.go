@@ -6,4 +6,7 @@ package dynamodb
 // Exports for use in tests only.
 var (
 	ListTags = listTags
+
+	ExpandTableItems = expandTableItems
+	TableItemHashes  = tableItemHashes
 )
@@ -0,0 +1,662 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+const (
+	// Maximum size of the items document, whether inline or in S3.
+	tableItemsMaxSourceSize = 16 * 1024 * 1024
+
+	// Maximum number of put or delete requests in a BatchWriteItem request.
+	tableItemsBatchWriteMaxItems = 25
+	// Maximum number of keys in a BatchGetItem request.
+	tableItemsBatchGetMaxKeys = 100
+)
+
+var (
+	// Backoff between retries of unprocessed items or keys, which are returned when throughput is exceeded.
+	tableItemsRetryOptions = retry.Options{
+		BackoffMinDuration: 100 * time.Millisecond,
+		BackoffMultiplier:  1.5,
+	}
+)
+
+// @SDKResource("aws_dynamodb_table_items")
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffTableItemsHashes,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "s3_bucket"},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, tableItemsMaxSourceSize),
+					validateTableItems,
+				),
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"s3_key"},
+			},
+			"s3_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"s3_bucket"},
+			},
+			"s3_object_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"s3_bucket"},
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func validateTableItems(v interface{}, k string) (ws []string, errors []error) {
+	_, err := expandTableItems(strings.NewReader(v.(string)))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Invalid format of %q: %s", k, err))
+	}
+	return
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tableName := d.Get("table_name").(string)
+
+	// Set the ID before writing so that any items written before a failure are tracked and deleted on destroy.
+	d.SetId(id.UniqueId())
+
+	if err := syncTableItems(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	keys, err := expandTableItemKeys(d.Get("item_hashes").(map[string]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items (%s): %s", tableName, d.Id(), err)
+	}
+
+	items, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing Items (%s) from state", tableName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items (%s): %s", tableName, d.Id(), err)
+	}
+
+	// Items that no longer exist, or that have changed, are detected as differences from the configured items.
+	hashes := make(map[string]string, len(items))
+	for _, item := range items {
+		key, hash, err := tableItemKeyAndHash(item, d.Get("hash_key").(string), d.Get("range_key").(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items (%s): %s", tableName, d.Id(), err)
+		}
+
+		hashes[key] = hash
+	}
+
+	d.Set("item_hashes", hashes)
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := syncTableItems(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items (%s): %s", d.Get("table_name").(string), d.Id(), err)
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	keys, err := expandTableItemKeys(d.Get("item_hashes").(map[string]interface{}))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items (%s): %s", tableName, d.Id(), err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) Items (%s): %d items", tableName, d.Id(), len(keys))
+	err = batchWriteTableItems(ctx, conn, tableName, nil, keys)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items (%s): %s", tableName, d.Id(), err)
+	}
+
+	return diags
+}
+
+// customizeDiffTableItemsHashes sets the planned value of `item_hashes` from the configured items,
+// so that differences are shown item-by-item.
+func customizeDiffTableItemsHashes(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"hash_key", "items", "range_key", "s3_bucket", "s3_key", "s3_object_version"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("item_hashes")
+		}
+	}
+
+	// Avoid reading the S3 object on every plan; it is read only when the object it refers to changes.
+	if d.Id() != "" && d.Get("items").(string) == "" && !d.HasChanges("s3_bucket", "s3_key", "s3_object_version") {
+		return nil
+	}
+
+	items, err := tableItemsFromResource(ctx, d, meta)
+
+	// The S3 object may be created in the same apply.
+	if tfawserr_sdkv2.ErrCodeEquals(err, "NoSuchBucket", "NoSuchKey") {
+		return d.SetNewComputed("item_hashes")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	hashes, err := tableItemHashes(items, d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if old := flex.ExpandStringValueMap(d.Get("item_hashes").(map[string]interface{})); tableItemHashesEqual(old, hashes) {
+		return nil
+	}
+
+	return d.SetNew("item_hashes", hashes)
+}
+
+// syncTableItems writes the configured items that are new or have changed since the last apply
+// and deletes the items that are no longer configured.
+func syncTableItems(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn := meta.(*conns.AWSClient).DynamoDBConn(ctx)
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := tableItemsFromResource(ctx, d, meta)
+
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("item_hashes")
+	old := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	var puts []map[string]*dynamodb.AttributeValue
+	keys := make(map[string]struct{}, len(items))
+	hashes := make(map[string]string, len(old)+len(items))
+	for k, v := range old {
+		hashes[k] = v
+	}
+
+	for _, item := range items {
+		key, hash, err := tableItemKeyAndHash(item, hashKey, rangeKey)
+
+		if err != nil {
+			return err
+		}
+
+		keys[key] = struct{}{}
+		hashes[key] = hash
+
+		if old[key] != hash {
+			puts = append(puts, item)
+		}
+	}
+
+	var deletes []map[string]*dynamodb.AttributeValue
+
+	for k := range old {
+		if _, ok := keys[k]; ok {
+			continue
+		}
+
+		key, err := ExpandTableItemAttributes(k)
+
+		if err != nil {
+			return err
+		}
+
+		deletes = append(deletes, key)
+	}
+
+	log.Printf("[DEBUG] Writing DynamoDB Table (%s) Items: %d puts, %d deletes", tableName, len(puts), len(deletes))
+	if err := batchWriteTableItems(ctx, conn, tableName, puts, deletes); err != nil {
+		// Track every item that may have been written or not yet deleted, so that none are orphaned.
+		// The next refresh removes those that don't exist.
+		d.Set("item_hashes", hashes)
+
+		return err
+	}
+
+	return nil
+}
+
+// tableItemsFromResource returns the items configured inline or in an S3 object.
+func tableItemsFromResource(ctx context.Context, d interface{ Get(string) any }, meta interface{}) ([]map[string]*dynamodb.AttributeValue, error) {
+	if v := d.Get("items").(string); v != "" {
+		return expandTableItems(strings.NewReader(v))
+	}
+
+	bucket, key := d.Get("s3_bucket").(string), d.Get("s3_key").(string)
+	input := &s3_sdkv2.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if v := d.Get("s3_object_version").(string); v != "" {
+		input.VersionId = aws.String(v)
+	}
+
+	output, err := meta.(*conns.AWSClient).S3Client(ctx).GetObject(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+	defer output.Body.Close()
+
+	if v := aws.Int64Value(output.ContentLength); v > tableItemsMaxSourceSize {
+		return nil, fmt.Errorf("S3 Bucket (%s) Object (%s) size (%d bytes) exceeds the maximum items size (%d bytes)", bucket, key, v, tableItemsMaxSourceSize)
+	}
+
+	items, err := expandTableItems(io.LimitReader(output.Body, tableItemsMaxSourceSize))
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 Bucket (%s) Object (%s): %w", bucket, key, err)
+	}
+
+	return items, nil
+}
+
+// expandTableItems decodes items in DynamoDB JSON format, either as a JSON array or as a sequence of JSON objects
+// (e.g. one per line).
+func expandTableItems(r io.Reader) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	dec := json.NewDecoder(r)
+	tok, err := dec.Token()
+
+	if err == io.EOF {
+		return nil, errors.New("no items")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Decoding failed: %s", err)
+	}
+
+	switch tok {
+	case json.Delim('['):
+		for dec.More() {
+			var item map[string]*dynamodb.AttributeValue
+			if err := dec.Decode(&item); err != nil {
+				return nil, fmt.Errorf("Decoding item %d failed: %s", len(items), err)
+			}
+			items = append(items, item)
+		}
+
+		if _, err := dec.Token(); err != nil {
+			return nil, fmt.Errorf("Decoding failed: %s", err)
+		}
+	case json.Delim('{'):
+		// Re-read the first object in full.
+		dec = json.NewDecoder(io.MultiReader(strings.NewReader("{"), dec.Buffered(), r))
+
+		for {
+			var item map[string]*dynamodb.AttributeValue
+			if err := dec.Decode(&item); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("Decoding item %d failed: %s", len(items), err)
+			}
+			items = append(items, item)
+		}
+	default:
+		return nil, fmt.Errorf("Decoding failed: expected a JSON array or object, got %v", tok)
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("Decoding failed: unexpected data after items")
+	}
+
+	return items, nil
+}
+
+// tableItemHashes returns the hash of each item, keyed by the item's primary key.
+func tableItemHashes(items []map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (map[string]string, error) {
+	hashes := make(map[string]string, len(items))
+
+	for i, item := range items {
+		key, hash, err := tableItemKeyAndHash(item, hashKey, rangeKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		if _, ok := hashes[key]; ok {
+			return nil, fmt.Errorf("item %d: duplicate primary key %s", i, key)
+		}
+
+		hashes[key] = hash
+	}
+
+	return hashes, nil
+}
+
+func tableItemHashesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tableItemKeyAndHash returns an item's normalized primary key in DynamoDB JSON format and the SHA-256 hash of the
+// item's normalized attributes.
+func tableItemKeyAndHash(item map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (string, string, error) {
+	if _, ok := item[hashKey]; !ok {
+		return "", "", fmt.Errorf("missing hash key (%s)", hashKey)
+	}
+
+	if _, ok := item[rangeKey]; rangeKey != "" && !ok {
+		return "", "", fmt.Errorf("missing range key (%s)", rangeKey)
+	}
+
+	normalized := make(map[string]*dynamodb.AttributeValue, len(item))
+	for k, v := range item {
+		normalized[k] = normalizeTableItemAttributeValue(v)
+	}
+
+	// The key is normalized too, so that equivalent keys (e.g. {"N":"1.0"} and {"N":"1"}) identify the same item.
+	key, err := flattenTableItemAttributes(BuildTableItemQueryKey(normalized, hashKey, rangeKey))
+
+	if err != nil {
+		return "", "", err
+	}
+
+	v, err := flattenTableItemAttributes(normalized)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	hash := sha256.Sum256([]byte(v))
+
+	return strings.TrimSpace(key), hex.EncodeToString(hash[:]), nil
+}
+
+// normalizeTableItemAttributeValue returns a copy of an attribute value in the form returned by DynamoDB,
+// with numbers in canonical form and set members sorted.
+func normalizeTableItemAttributeValue(v *dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if v == nil {
+		return nil
+	}
+
+	output := *v
+
+	if v.N != nil {
+		output.N = aws.String(normalizeTableItemNumber(aws.StringValue(v.N)))
+	}
+
+	if v.NS != nil {
+		ns := make([]string, len(v.NS))
+		for i, n := range v.NS {
+			ns[i] = normalizeTableItemNumber(aws.StringValue(n))
+		}
+		sort.Strings(ns)
+		output.NS = aws.StringSlice(ns)
+	}
+
+	if v.SS != nil {
+		ss := aws.StringValueSlice(v.SS)
+		sort.Strings(ss)
+		output.SS = aws.StringSlice(ss)
+	}
+
+	if v.BS != nil {
+		bs := make([][]byte, len(v.BS))
+		copy(bs, v.BS)
+		sort.Slice(bs, func(i, j int) bool {
+			return string(bs[i]) < string(bs[j])
+		})
+		output.BS = bs
+	}
+
+	if v.L != nil {
+		l := make([]*dynamodb.AttributeValue, len(v.L))
+		for i, e := range v.L {
+			l[i] = normalizeTableItemAttributeValue(e)
+		}
+		output.L = l
+	}
+
+	if v.M != nil {
+		m := make(map[string]*dynamodb.AttributeValue, len(v.M))
+		for k, e := range v.M {
+			m[k] = normalizeTableItemAttributeValue(e)
+		}
+		output.M = m
+	}
+
+	return &output
+}
+
+// normalizeTableItemNumber returns the canonical form of a DynamoDB number, e.g. "1.50" and "1.5e0" become "1.5".
+func normalizeTableItemNumber(s string) string {
+	// DynamoDB numbers have up to 38 digits of precision.
+	f, _, err := big.ParseFloat(strings.TrimSpace(s), 10, 256, big.ToNearestEven)
+
+	if err != nil {
+		return s
+	}
+
+	return f.Text('g', -1)
+}
+
+func expandTableItemKeys(tfMap map[string]interface{}) ([]map[string]*dynamodb.AttributeValue, error) {
+	keys := make([]map[string]*dynamodb.AttributeValue, 0, len(tfMap))
+
+	for k := range tfMap {
+		key, err := ExpandTableItemAttributes(k)
+
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > tableItemsBatchGetMaxKeys {
+			n = tableItemsBatchGetMaxKeys
+		}
+		batch := keys[:n]
+		keys = keys[n:]
+
+		for r := retry.BeginWithOptions(tableItemsRetryOptions); len(batch) > 0 && r.Continue(ctx); {
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]*dynamodb.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           batch,
+					},
+				},
+			}
+
+			output, err := conn.BatchGetItemWithContext(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			batch = nil
+			if v, ok := output.UnprocessedKeys[tableName]; ok && v != nil {
+				batch = v.Keys
+			}
+		}
+
+		if len(batch) > 0 {
+			return nil, fmt.Errorf("%d unprocessed keys: %w", len(batch), ctx.Err())
+		}
+	}
+
+	return items, nil
+}
+
+// batchWriteTableItems puts and deletes items in batches, retrying unprocessed items with exponential backoff.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, puts, deletes []map[string]*dynamodb.AttributeValue) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(puts)+len(deletes))
+
+	for _, v := range puts {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: v,
+			},
+		})
+	}
+
+	for _, v := range deletes {
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: v,
+			},
+		})
+	}
+
+	for len(requests) > 0 {
+		n := len(requests)
+		if n > tableItemsBatchWriteMaxItems {
+			n = tableItemsBatchWriteMaxItems
+		}
+		batch := requests[:n]
+		requests = requests[n:]
+
+		for r := retry.BeginWithOptions(tableItemsRetryOptions); len(batch) > 0 && r.Continue(ctx); {
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]*dynamodb.WriteRequest{
+					tableName: batch,
+				},
+			}
+
+			output, err := conn.BatchWriteItemWithContext(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			batch = output.UnprocessedItems[tableName]
+		}
+
+		if len(batch) > 0 {
+			return fmt.Errorf("%d unprocessed items: %w", len(batch)+len(requests), ctx.Err())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,569 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestExpandTableItems(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		input     string
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:      "array",
+			input:     `[{"id": {"S": "a"}}, {"id": {"S": "b"}, "n": {"N": "1"}}]`,
+			wantCount: 2,
+		},
+		{
+			name: "JSON lines",
+			input: `{"id": {"S": "a"}}
+{"id": {"S": "b"}, "n": {"N": "1"}}
+{"id": {"S": "c"}}
+`,
+			wantCount: 3,
+		},
+		{
+			name:      "empty array",
+			input:     `[]`,
+			wantCount: 0,
+		},
+		{
+			name:    "empty",
+			input:   ``,
+			wantErr: true,
+		},
+		{
+			name:    "invalid item",
+			input:   `[{"id": "a"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "trailing data",
+			input:   `[{"id": {"S": "a"}}] x`,
+			wantErr: true,
+		},
+		{
+			name:    "scalar",
+			input:   `"a"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.ExpandTableItems(strings.NewReader(tc.input))
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != tc.wantCount {
+				t.Errorf("got %d items, expected %d", len(got), tc.wantCount)
+			}
+		})
+	}
+}
+
+func TestTableItemHashes(t *testing.T) {
+	t.Parallel()
+
+	expand := func(t *testing.T, s string) []map[string]*dynamodb.AttributeValue {
+		t.Helper()
+
+		items, err := tfdynamodb.ExpandTableItems(strings.NewReader(s))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return items
+	}
+
+	t.Run("keys", func(t *testing.T) {
+		t.Parallel()
+
+		hashes, err := tfdynamodb.TableItemHashes(expand(t, `[
+  {"pk": {"S": "a"}, "sk": {"N": "1"}, "v": {"S": "x"}},
+  {"pk": {"S": "a"}, "sk": {"N": "2"}, "v": {"S": "x"}}
+]`), "pk", "sk")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for _, key := range []string{`{"pk":{"S":"a"},"sk":{"N":"1"}}`, `{"pk":{"S":"a"},"sk":{"N":"2"}}`} {
+			if _, ok := hashes[key]; !ok {
+				t.Errorf("missing key %s in %v", key, hashes)
+			}
+		}
+
+		if got, want := len(hashes), 2; got != want {
+			t.Errorf("got %d hashes, expected %d", got, want)
+		}
+	})
+
+	t.Run("normalized", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := tfdynamodb.TableItemHashes(expand(t, `[{"id": {"S": "a"}, "n": {"N": "1.50"}, "ss": {"SS": ["y", "x"]}, "m": {"M": {"ns": {"NS": ["10", "2.0"]}}}}]`), "id", "")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		b, err := tfdynamodb.TableItemHashes(expand(t, `[{"m": {"M": {"ns": {"NS": ["2", "10"]}}}, "ss": {"SS": ["x", "y"]}, "n": {"N": "1.5"}, "id": {"S": "a"}}]`), "id", "")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		c, err := tfdynamodb.TableItemHashes(expand(t, `[{"id": {"S": "a"}, "n": {"N": "1.6"}, "ss": {"SS": ["x", "y"]}, "m": {"M": {"ns": {"NS": ["2", "10"]}}}}]`), "id", "")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		key := `{"id":{"S":"a"}}`
+
+		if a[key] != b[key] {
+			t.Errorf("got different hashes %s and %s for equivalent items", a[key], b[key])
+		}
+
+		if a[key] == c[key] {
+			t.Errorf("got same hash %s for different items", a[key])
+		}
+	})
+
+	t.Run("normalized keys", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := tfdynamodb.TableItemHashes(expand(t, `[{"pk": {"N": "1.0"}, "sk": {"SS": ["y", "x"]}, "v": {"S": "x"}}]`), "pk", "sk")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		b, err := tfdynamodb.TableItemHashes(expand(t, `[{"pk": {"N": "1"}, "sk": {"SS": ["x", "y"]}, "v": {"S": "x"}}]`), "pk", "sk")
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		key := `{"pk":{"N":"1"},"sk":{"SS":["x","y"]}}`
+
+		for _, hashes := range []map[string]string{a, b} {
+			if _, ok := hashes[key]; !ok {
+				t.Errorf("missing key %s in %v", key, hashes)
+			}
+		}
+
+		if a[key] != b[key] {
+			t.Errorf("got different hashes %s and %s for equivalent items", a[key], b[key])
+		}
+	})
+
+	t.Run("missing hash key", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := tfdynamodb.TableItemHashes(expand(t, `[{"v": {"S": "a"}}]`), "id", ""); err == nil {
+			t.Error("expected error, got none")
+		}
+	})
+
+	t.Run("missing range key", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := tfdynamodb.TableItemHashes(expand(t, `[{"id": {"S": "a"}}]`), "id", "sk"); err == nil {
+			t.Error("expected error, got none")
+		}
+	})
+
+	t.Run("duplicate key", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := tfdynamodb.TableItemHashes(expand(t, `[{"id": {"S": "a"}, "v": {"N": "1"}}, {"id": {"S": "a"}, "v": {"N": "2"}}]`), "id", ""); err == nil {
+			t.Error("expected error, got none")
+		}
+	})
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, `[
+  {"id": {"S": "one"}, "value": {"N": "1"}},
+  {"id": {"S": "two"}, "value": {"N": "2"}}
+]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"id":{"S":"one"}}`),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"id":{"S":"two"}}`),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_dynamodb_table.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, `[{"id": {"S": "one"}, "value": {"N": "1"}}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdynamodb.ResourceTableItems(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, `[
+  {"id": {"S": "one"}, "value": {"N": "1"}},
+  {"id": {"S": "two"}, "value": {"N": "2"}}
+]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+				),
+			},
+			{
+				// JSON lines, one item changed, one removed and one added.
+				Config: testAccTableItemsConfig_basic(rName, `{"id": {"S": "one"}, "value": {"N": "10"}}
+{"id": {"S": "three"}, "value": {"N": "3"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemNotExists(ctx, "aws_dynamodb_table.test", `{"id": {"S": "two"}}`),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"id":{"S":"one"}}`),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"id":{"S":"three"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "30"),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_s3(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_s3(rName, "{\"id\": {\"S\": \"one\"}, \"value\": {\"N\": \"1\"}}\n{\"id\": {\"S\": \"two\"}, \"value\": {\"N\": \"2\"}}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", "aws_s3_object.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_key", "aws_s3_object.test", "key"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_object_version", "aws_s3_object.test", "version_id"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_s3(rName, "{\"id\": {\"S\": \"one\"}, \"value\": {\"N\": \"1\"}}\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExist(ctx, resourceName),
+					testAccCheckTableItemNotExists(ctx, "aws_dynamodb_table.test", `{"id": {"S": "two"}}`),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			for _, key := range testAccTableItemsKeys(rs) {
+				attributes, err := tfdynamodb.ExpandTableItemAttributes(key)
+				if err != nil {
+					return err
+				}
+
+				_, err = tfdynamodb.FindTableItem(ctx, conn, rs.Primary.Attributes["table_name"], attributes)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DynamoDB table item %s still exists", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsExist(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		for _, key := range testAccTableItemsKeys(rs) {
+			attributes, err := tfdynamodb.ExpandTableItemAttributes(key)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfdynamodb.FindTableItem(ctx, conn, rs.Primary.Attributes["table_name"], attributes)
+
+			if err != nil {
+				return fmt.Errorf("DynamoDB table item %s: %w", key, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
+
+		attributes, err := tfdynamodb.ExpandTableItemAttributes(key)
+		if err != nil {
+			return err
+		}
+
+		_, err = tfdynamodb.FindTableItem(ctx, conn, rs.Primary.Attributes["name"], attributes)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DynamoDB table item %s still exists", key)
+	}
+}
+
+func testAccTableItemsKeys(rs *terraform.ResourceState) []string {
+	var keys []string
+
+	for k := range rs.Primary.Attributes {
+		if k == "item_hashes.%" {
+			continue
+		}
+
+		if key, ok := strings.CutPrefix(k, "item_hashes."); ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func testAccTableItemsConfig_basic(rName, items string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = <<ITEMS
+%[2]s
+ITEMS
+}
+`, rName, items)
+}
+
+func testAccTableItemsConfig_rangeKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "pk"
+  range_key      = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  # More items than fit in a single BatchWriteItem request.
+  items = jsonencode([for i in range(30) : {
+    pk    = { S = "partition-${i %% 3}" }
+    sk    = { N = tostring(i) }
+    value = { S = "value-${i}" }
+  }])
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_s3(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 10
+  write_capacity = 10
+  hash_key       = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "items.json"
+  content = %[2]q
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  s3_bucket         = aws_s3_object.test.bucket
+  s3_key            = aws_s3_object.test.key
+  s3_object_version = aws_s3_object.test.version_id
+}
+`, rName, content)
+}
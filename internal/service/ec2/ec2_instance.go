@@ -19,6 +19,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -575,6 +576,30 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			"modification_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_stop_start": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"hibernate": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"wait_for_status_checks": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"monitoring": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -907,10 +932,96 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
+			customizeDiffInstanceModification,
 		),
 	}
 }
 
+// customizeDiffInstanceModification refuses at plan time any in-place change that would stop and start a running instance
+// when the stop/start would be destructive or the configured modification policy forbids it.
+func customizeDiffInstanceModification(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.HasChange("instance_type") && (diff.Get("user_data_replace_on_change").(bool) || !diff.HasChanges("user_data", "user_data_base64")) {
+		return nil
+	}
+
+	policy := expandInstanceModificationPolicy(diff.Get("modification_policy").([]interface{}))
+
+	if policy.allowStopStart && policy.hibernate && !diff.Get("hibernation").(bool) {
+		return fmt.Errorf("modification_policy.hibernate requires hibernation to be enabled on EC2 Instance (%s)", diff.Id())
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	instance, err := FindInstanceByID(ctx, conn, diff.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance (%s): %w", diff.Id(), err)
+	}
+
+	// Only a running instance is stopped and started.
+	if state := aws.StringValue(instance.State.Name); state != ec2.InstanceStateNamePending && state != ec2.InstanceStateNameRunning {
+		return nil
+	}
+
+	if !policy.allowStopStart {
+		return fmt.Errorf("changing instance_type or user_data of EC2 Instance (%s) requires the instance to be stopped and started, which modification_policy.allow_stop_start forbids; stop the instance first", diff.Id())
+	}
+
+	if policy.hibernate && diff.HasChange("instance_type") {
+		return fmt.Errorf("instance_type of EC2 Instance (%s) cannot be changed while hibernated; set modification_policy.hibernate to false", diff.Id())
+	}
+
+	if v := aws.StringValue(instance.RootDeviceType); v == ec2.DeviceTypeInstanceStore {
+		return fmt.Errorf("EC2 Instance (%s) has an instance store root volume and cannot be stopped without losing data; changing instance_type or user_data in place is not supported", diff.Id())
+	}
+
+	groupName, err := findAutoScalingGroupNameByInstanceID(ctx, meta.(*conns.AWSClient).AutoScalingConn(ctx), diff.Id())
+
+	// Auto Scaling group membership is unknown without the autoscaling:DescribeAutoScalingInstances permission.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+		log.Printf("[WARN] Unable to determine Auto Scaling group of EC2 Instance (%s), which may replace the instance while it is stopped: %s", diff.Id(), err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Auto Scaling group of EC2 Instance (%s): %w", diff.Id(), err)
+	}
+
+	if groupName != "" {
+		return fmt.Errorf("EC2 Instance (%s) is a member of Auto Scaling group (%s), which may replace the instance while it is stopped; changing instance_type or user_data in place is not supported", diff.Id(), groupName)
+	}
+
+	return nil
+}
+
+// findAutoScalingGroupNameByInstanceID returns the name of the Auto Scaling group that the specified EC2 instance belongs to,
+// or an empty string if the instance doesn't belong to an Auto Scaling group.
+func findAutoScalingGroupNameByInstanceID(ctx context.Context, conn *autoscaling.AutoScaling, id string) (string, error) {
+	output, err := conn.DescribeAutoScalingInstancesWithContext(ctx, &autoscaling.DescribeAutoScalingInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range output.AutoScalingInstances {
+		if v != nil && aws.StringValue(v.InstanceId) == id {
+			return aws.StringValue(v.AutoScalingGroupName), nil
+		}
+	}
+
+	return "", nil
+}
+
 func iopsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	// Suppress diff if volume_type is not io1, io2, or gp3 and iops is unset or configured as 0
 	i := strings.LastIndexByte(k, '.')
@@ -1667,6 +1778,8 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		// to account for behaviors occurring outside terraform.
 		// Only one attribute can be modified at a time, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
+		policy := expandInstanceModificationPolicy(d.Get("modification_policy").([]interface{}))
+
 		if d.HasChange("instance_type") {
 			log.Printf("[INFO] Modifying instance type %s", d.Id())
 
@@ -1677,7 +1790,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				},
 			}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, policy, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
 			}
 		}
@@ -1704,7 +1817,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				},
 			}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, policy, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data: %s", d.Id(), err)
 			}
 		}
@@ -1727,7 +1840,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				},
 			}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, policy, d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data base64: %s", d.Id(), err)
 			}
 		}
@@ -2070,15 +2183,56 @@ func disableInstanceAPITermination(ctx context.Context, conn *ec2.EC2, id string
 	return nil
 }
 
+// instanceModificationPolicy controls how an EC2 instance is stopped and started
+// to modify attributes that can only be changed on a stopped instance.
+type instanceModificationPolicy struct {
+	allowStopStart      bool
+	hibernate           bool
+	waitForStatusChecks bool
+}
+
+// expandInstanceModificationPolicy returns the configured modification policy,
+// or the historical stop/start behavior if no policy is configured.
+func expandInstanceModificationPolicy(tfList []interface{}) *instanceModificationPolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &instanceModificationPolicy{
+			allowStopStart: true,
+		}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &instanceModificationPolicy{
+		allowStopStart:      tfMap["allow_stop_start"].(bool),
+		hibernate:           tfMap["hibernate"].(bool),
+		waitForStatusChecks: tfMap["wait_for_status_checks"].(bool),
+	}
+}
+
 // modifyInstanceAttributeWithStopStart modifies a specific attribute provided
 // as input by first stopping the EC2 instance before the modification
 // and then starting up the EC2 instance after modification.
+// If the policy forbids stop/start, the attribute is modified directly, which succeeds only on a stopped instance.
 // Reference: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Stop_Start.html
-func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyInstanceAttributeInput) error {
+func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyInstanceAttributeInput, policy *instanceModificationPolicy, timeout time.Duration) error {
 	id := aws.StringValue(input.InstanceId)
 
-	if err := stopInstance(ctx, conn, id, false, InstanceStopTimeout); err != nil {
-		return err
+	if !policy.allowStopStart {
+		if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
+			return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", id, err)
+		}
+
+		return nil
+	}
+
+	if policy.hibernate {
+		if err := hibernateInstance(ctx, conn, id, InstanceStopTimeout); err != nil {
+			return err
+		}
+	} else {
+		if err := stopInstance(ctx, conn, id, false, InstanceStopTimeout); err != nil {
+			return err
+		}
 	}
 
 	if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
@@ -2103,6 +2257,12 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, in
 		return fmt.Errorf("starting EC2 Instance (%s): waiting for completion: %w", id, err)
 	}
 
+	if policy.waitForStatusChecks {
+		if _, err := waitInstanceStatusChecksPassed(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) status checks: %w", id, err)
+		}
+	}
+
 	return nil
 }
 
@@ -3016,6 +3176,27 @@ func stopInstance(ctx context.Context, conn *ec2.EC2, id string, force bool, tim
 	return nil
 }
 
+// hibernateInstance hibernates an EC2 instance and waits for the instance to stop.
+func hibernateInstance(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
+	tflog.Info(ctx, "Hibernating EC2 Instance", map[string]any{
+		"ec2_instance_id": id,
+	})
+	_, err := conn.StopInstancesWithContext(ctx, &ec2.StopInstancesInput{
+		Hibernate:   aws.Bool(true),
+		InstanceIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("hibernating EC2 Instance (%s): %w", id, err)
+	}
+
+	if _, err := waitInstanceStopped(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("hibernating EC2 Instance (%s): waiting for completion: %w", id, err)
+	}
+
+	return nil
+}
+
 // terminateInstance shuts down an EC2 instance and waits for the instance to be deleted.
 func terminateInstance(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Terminating EC2 Instance: %s", id)
@@ -3164,6 +3345,25 @@ func waitInstanceStopped(ctx context.Context, conn *ec2.EC2, id string, timeout
 	return nil, err
 }
 
+func waitInstanceStatusChecksPassed(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.InstanceStatus, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{ec2.SummaryStatusInitializing, ec2.SummaryStatusInsufficientData, ec2.SummaryStatusNotApplicable},
+		Target:     []string{ec2.SummaryStatusOk},
+		Refresh:    StatusInstanceStatusChecks(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.InstanceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func userDataHashSum(user_data string) string {
 	// Check whether the user_data is not Base64 encoded.
 	// Always calculate hash of base64 decoded value since we
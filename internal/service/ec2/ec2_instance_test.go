@@ -5014,6 +5014,110 @@ func TestAccEC2Instance_hibernation(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_ModificationPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_modificationPolicy(rName, "t2.medium", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.medium"),
+					resource.TestCheckResourceAttr(resourceName, "modification_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "modification_policy.0.allow_stop_start", "true"),
+					resource.TestCheckResourceAttr(resourceName, "modification_policy.0.hibernate", "false"),
+					resource.TestCheckResourceAttr(resourceName, "modification_policy.0.wait_for_status_checks", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"modification_policy", "user_data_replace_on_change"},
+			},
+			{
+				Config: testAccInstanceConfig_modificationPolicy(rName, "t2.large", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.large"),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_ModificationPolicy_forbidStopStart(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_modificationPolicy(rName, "t2.medium", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "modification_policy.0.allow_stop_start", "false"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_modificationPolicy(rName, "t2.large", false),
+				ExpectError: regexache.MustCompile(`modification_policy.allow_stop_start forbids`),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_ModificationPolicy_hibernate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_modificationPolicyHibernate(rName, "m5.large", "hello world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "modification_policy.0.hibernate", "true"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_modificationPolicyHibernate(rName, "m5.large", "new world"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", "running"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_modificationPolicyHibernate(rName, "m5.xlarge", "new world"),
+				ExpectError: regexache.MustCompile(`cannot be changed while hibernated`),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_metadataOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -6067,6 +6171,57 @@ resource "aws_instance" "test" {
 `, rName, instanceType, userData))
 }
 
+func testAccInstanceConfig_modificationPolicy(rName, instanceType string, allowStopStart bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type = %[2]q
+
+  modification_policy {
+    allow_stop_start = %[3]t
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, allowStopStart))
+}
+
+func testAccInstanceConfig_modificationPolicyHibernate(rName, instanceType, userData string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+# must be >= m3 and have an encrypted root volume to enable hibernation
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  hibernation   = true
+  instance_type = %[2]q
+  subnet_id     = aws_subnet.test.id
+  user_data     = %[3]q
+
+  root_block_device {
+    encrypted   = true
+    volume_size = 20
+  }
+
+  modification_policy {
+    hibernate = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, userData))
+}
+
 func testAccInstanceConfig_typeAndUserDataBase64(rName, instanceType, userData string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
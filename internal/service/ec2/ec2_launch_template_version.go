@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_launch_template_version", name="Launch Template Version")
+func ResourceLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceLaunchTemplateVersionRead,
+		UpdateWithoutTimeout: resourceLaunchTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceLaunchTemplateVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLaunchTemplateVersionImport,
+		},
+
+		Schema: func() map[string]*schema.Schema {
+			// The Launch Template Version Schema is based on the Launch Template schema.
+			s := ResourceLaunchTemplate().SchemaMap()
+
+			// Remove attributes that describe the launch template rather than a version.
+			delete(s, "arn")
+			delete(s, "default_version")
+			delete(s, "description")
+			delete(s, "latest_version")
+			delete(s, "name")
+			delete(s, "name_prefix")
+			delete(s, "update_default_version")
+			delete(s, names.AttrTags)
+			delete(s, names.AttrTagsAll)
+
+			// Launch template versions are immutable.
+			launchTemplateVersionForceNew(s)
+
+			s["create_time"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+			s["created_by"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+			s["default_version"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			}
+			s["launch_template_id"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			}
+			s["prune_on_destroy"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			}
+			s["source_version"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			}
+			s["version_description"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			}
+			s["version_number"] = &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			}
+
+			return s
+		}(),
+	}
+}
+
+// launchTemplateVersionForceNew marks every configurable attribute, including those in nested blocks, as ForceNew.
+func launchTemplateVersionForceNew(s map[string]*schema.Schema) {
+	for _, v := range s {
+		if v.Computed && !v.Optional {
+			continue
+		}
+
+		v.ForceNew = true
+
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			launchTemplateVersionForceNew(elem.Schema)
+		}
+	}
+}
+
+func resourceLaunchTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+	input := &ec2.CreateLaunchTemplateVersionInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	if v, err := expandRequestLaunchTemplateData(ctx, conn, d); err == nil {
+		input.LaunchTemplateData = v
+	} else {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if v, ok := d.GetOk("source_version"); ok {
+		input.SourceVersion = aws.String(v.(string))
+
+		// Inherit the source version's user data unless it is overridden.
+		if d.Get("user_data").(string) == "" {
+			input.LaunchTemplateData.UserData = nil
+		}
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLaunchTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Launch Template (%s) Version: %s", launchTemplateID, err)
+	}
+
+	if warning := output.Warning; warning != nil {
+		for _, v := range warning.Errors {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("creating EC2 Launch Template (%s) Version", launchTemplateID),
+				Detail:   fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message)),
+			})
+		}
+	}
+
+	version := strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10)
+	resourceID, err := flex.FlattenResourceId([]string{launchTemplateID, version}, launchTemplateVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(resourceID)
+
+	if d.Get("default_version").(bool) {
+		if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLaunchTemplateVersionRead(ctx, d, meta)...)
+}
+
+func resourceLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), launchTemplateVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	launchTemplateID, version := parts[0], parts[1]
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Launch Template Version %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(ltv.CreateTime).Format(time.RFC3339))
+	d.Set("created_by", ltv.CreatedBy)
+	// Only report a configured default version that is no longer the default, so that it is made the default again.
+	d.Set("default_version", d.Get("default_version").(bool) && aws.BoolValue(ltv.DefaultVersion))
+	d.Set("launch_template_id", ltv.LaunchTemplateId)
+	d.Set("version_description", ltv.VersionDescription)
+	d.Set("version_number", ltv.VersionNumber)
+
+	// A version created from a source version inherits the launch template data that isn't configured,
+	// so only the configured data is kept. Launch template versions are immutable.
+	if d.Get("source_version").(string) == "" {
+		if err := flattenResponseLaunchTemplateData(ctx, conn, d, ltv.LaunchTemplateData); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+func resourceLaunchTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("default_version") && d.Get("default_version").(bool) {
+		launchTemplateID := d.Get("launch_template_id").(string)
+		version := strconv.Itoa(d.Get("version_number").(int))
+
+		if err := setLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLaunchTemplateVersionRead(ctx, d, meta)...)
+}
+
+func resourceLaunchTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if !d.Get("prune_on_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining EC2 Launch Template Version: %s", d.Id())
+		return diags
+	}
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+	version := strconv.Itoa(d.Get("version_number").(int))
+
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	// The default version of a launch template can't be deleted.
+	if aws.BoolValue(ltv.DefaultVersion) {
+		return sdkdiag.AppendWarningf(diags, "EC2 Launch Template Version (%s) is the launch template's default version and can't be deleted; retaining it", d.Id())
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Launch Template Version: %s", d.Id())
+	output, err := conn.DeleteLaunchTemplateVersionsWithContext(ctx, &ec2.DeleteLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+		Versions:         aws.StringSlice([]string{version}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLaunchTemplateIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	for _, v := range output.UnsuccessfullyDeletedLaunchTemplateVersions {
+		if v == nil || v.ResponseError == nil {
+			continue
+		}
+
+		switch code := aws.StringValue(v.ResponseError.Code); code {
+		case ec2.LaunchTemplateErrorCodeLaunchTemplateIdDoesNotExist, ec2.LaunchTemplateErrorCodeLaunchTemplateVersionDoesNotExist:
+			continue
+		default:
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Launch Template Version (%s): %s: %s", d.Id(), code, aws.StringValue(v.ResponseError.Message))
+		}
+	}
+
+	return diags
+}
+
+func resourceLaunchTemplateVersionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.ExpandResourceId(d.Id(), launchTemplateVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Launch Template Version (%s): %w", d.Id(), err)
+	}
+
+	d.Set("default_version", ltv.DefaultVersion)
+	d.Set("prune_on_destroy", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const launchTemplateVersionResourceIDPartCount = 2
+
+func setLaunchTemplateDefaultVersion(ctx context.Context, conn *ec2.EC2, launchTemplateID, version string) error {
+	_, err := conn.ModifyLaunchTemplateWithContext(ctx, &ec2.ModifyLaunchTemplateInput{
+		DefaultVersion:   aws.String(version),
+		LaunchTemplateId: aws.String(launchTemplateID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("setting EC2 Launch Template (%s) default version (%s): %w", launchTemplateID, version, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2LaunchTemplateVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t3.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_id", launchTemplateResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "prune_on_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "canary"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+					resource.TestCheckResourceAttr(launchTemplateResourceName, "default_version", "1"),
+				),
+			},
+			{
+				// Staging a version doesn't cause the launch template, which ignores changes to its launch template data, to create another version.
+				Config:   testAccLaunchTemplateVersionConfig_basic(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prune_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					testAccCheckLaunchTemplateVersionDisappears(ctx, &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_sourceVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_sourceVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+					func(s *terraform.State) error {
+						data := v.LaunchTemplateData
+
+						if got, want := aws.StringValue(data.InstanceType), "t3.small"; got != want {
+							return fmt.Errorf("instance_type = %q, want %q", got, want)
+						}
+
+						// Inherited from the source version.
+						if got, want := aws.BoolValue(data.DisableApiTermination), true; got != want {
+							return fmt.Errorf("disable_api_termination = %t, want %t", got, want)
+						}
+
+						if got, want := aws.StringValue(data.UserData), "aGVsbG8="; got != want {
+							return fmt.Errorf("user_data = %q, want %q", got, want)
+						}
+
+						return nil
+					},
+					// Inherited launch template data isn't tracked.
+					resource.TestCheckResourceAttr(resourceName, "disable_api_termination", ""),
+					resource.TestCheckResourceAttr(resourceName, "user_data", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_sourceVersionRemoveAttribute(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_sourceVersionShutdownBehavior(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "instance_initiated_shutdown_behavior", "terminate"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionConfig_sourceVersion(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v2),
+					resource.TestCheckResourceAttr(resourceName, "instance_initiated_shutdown_behavior", ""),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "3"),
+					func(s *terraform.State) error {
+						if v := v2.LaunchTemplateData.InstanceInitiatedShutdownBehavior; v != nil {
+							return fmt.Errorf("instance_initiated_shutdown_behavior = %q, want none", aws.StringValue(v))
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_defaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_defaultVersion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, "aws_launch_template.test", 1),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionConfig_defaultVersion(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, "aws_launch_template.test", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_pruneOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var retained, pruned ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_pruneOnDestroy(rName, "t3.small", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &retained),
+				),
+			},
+			{
+				// Replacing a version that isn't pruned leaves the old version in place.
+				Config: testAccLaunchTemplateVersionConfig_pruneOnDestroy(rName, "t3.medium", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &pruned),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "3"),
+					testAccCheckLaunchTemplateVersionStillExists(ctx, &retained),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateVersionConfig_launchTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionDestroyed(ctx, &pruned),
+					testAccCheckLaunchTemplateVersionStillExists(ctx, &retained),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_pruneOnDestroyDefaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_pruneOnDestroyDefaultVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					testAccCheckLaunchTemplateDefaultVersion(ctx, "aws_launch_template.test", 2),
+				),
+			},
+			{
+				// The default version can't be deleted and is retained.
+				Config: testAccLaunchTemplateVersionConfig_launchTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionStillExists(ctx, &v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchTemplateVersionExists(ctx context.Context, n string, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Launch Template Version ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["launch_template_id"], rs.Primary.Attributes["version_number"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionStillExists(ctx context.Context, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, aws.StringValue(v.LaunchTemplateId), strconv.FormatInt(aws.Int64Value(v.VersionNumber), 10))
+
+		return err
+	}
+}
+
+func testAccCheckLaunchTemplateVersionDestroyed(ctx context.Context, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, aws.StringValue(v.LaunchTemplateId), strconv.FormatInt(aws.Int64Value(v.VersionNumber), 10))
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Launch Template (%s) Version (%d) still exists", aws.StringValue(v.LaunchTemplateId), aws.Int64Value(v.VersionNumber))
+	}
+}
+
+func testAccCheckLaunchTemplateVersionDisappears(ctx context.Context, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := conn.DeleteLaunchTemplateVersionsWithContext(ctx, &ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: v.LaunchTemplateId,
+			Versions:         aws.StringSlice([]string{strconv.FormatInt(aws.Int64Value(v.VersionNumber), 10)}),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckLaunchTemplateDefaultVersion(ctx context.Context, n string, want int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLaunchTemplateByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.Int64Value(output.DefaultVersionNumber); got != want {
+			return fmt.Errorf("EC2 Launch Template (%s) default version = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchTemplateVersionConfig_launchTemplate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                    = %[1]q
+  instance_type           = "t3.micro"
+  disable_api_termination = true
+  user_data               = "aGVsbG8="
+
+  # The launch template reads the launch template data of its latest version, which is created by aws_launch_template_version.
+  lifecycle {
+    ignore_changes = [description, disable_api_termination, instance_initiated_shutdown_behavior, instance_type, user_data]
+  }
+}
+`, rName)
+}
+
+func testAccLaunchTemplateVersionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionConfig_launchTemplate(rName), `
+resource "aws_launch_template_version" "test" {
+  launch_template_id  = aws_launch_template.test.id
+  instance_type       = "t3.small"
+  version_description = "canary"
+}
+`)
+}
+
+func testAccLaunchTemplateVersionConfig_sourceVersion(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionConfig_launchTemplate(rName), `
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = aws_launch_template.test.default_version
+  instance_type      = "t3.small"
+}
+`)
+}
+
+func testAccLaunchTemplateVersionConfig_sourceVersionShutdownBehavior(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionConfig_launchTemplate(rName), `
+resource "aws_launch_template_version" "test" {
+  launch_template_id                   = aws_launch_template.test.id
+  source_version                       = aws_launch_template.test.default_version
+  instance_type                        = "t3.small"
+  instance_initiated_shutdown_behavior = "terminate"
+}
+`)
+}
+
+func testAccLaunchTemplateVersionConfig_defaultVersion(rName string, defaultVersion bool) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionConfig_launchTemplate(rName), fmt.Sprintf(`
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = "1"
+  instance_type      = "t3.small"
+  default_version    = %[1]t
+}
+`, defaultVersion))
+}
+
+func testAccLaunchTemplateVersionConfig_pruneOnDestroy(rName, instanceType string, pruneOnDestroy bool) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionConfig_launchTemplate(rName), fmt.Sprintf(`
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = "1"
+  instance_type      = %[1]q
+  prune_on_destroy   = %[2]t
+}
+`, instanceType, pruneOnDestroy))
+}
+
+func testAccLaunchTemplateVersionConfig_pruneOnDestroyDefaultVersion(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionConfig_launchTemplate(rName), `
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = "1"
+  instance_type      = "t3.small"
+  default_version    = true
+  prune_on_destroy   = true
+}
+`)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_launch_template_versions")
+func DataSourceLaunchTemplateVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLaunchTemplateVersionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"launch_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"launch_template_id", "launch_template_name"},
+			},
+			"launch_template_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"launch_template_id", "launch_template_name"},
+			},
+			"max_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version_numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_version": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaunchTemplateVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeLaunchTemplateVersionsInput{}
+
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		input.LaunchTemplateId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("launch_template_name"); ok {
+		input.LaunchTemplateName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_version"); ok {
+		input.MaxVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("min_version"); ok {
+		input.MinVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = BuildCustomFilterList(v.(*schema.Set))
+	}
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindLaunchTemplateVersions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Versions: %s", err)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return aws.Int64Value(output[i].VersionNumber) < aws.Int64Value(output[j].VersionNumber)
+	})
+
+	var launchTemplateID, launchTemplateName string
+	var versionNumbers []int64
+	var versions []interface{}
+
+	for _, v := range output {
+		launchTemplateID = aws.StringValue(v.LaunchTemplateId)
+		launchTemplateName = aws.StringValue(v.LaunchTemplateName)
+		versionNumbers = append(versionNumbers, aws.Int64Value(v.VersionNumber))
+		versions = append(versions, map[string]interface{}{
+			"create_time":         aws.TimeValue(v.CreateTime).Format(time.RFC3339),
+			"created_by":          aws.StringValue(v.CreatedBy),
+			"default_version":     aws.BoolValue(v.DefaultVersion),
+			"version_description": aws.StringValue(v.VersionDescription),
+			"version_number":      aws.Int64Value(v.VersionNumber),
+		})
+	}
+
+	if launchTemplateID == "" {
+		launchTemplateID = d.Get("launch_template_id").(string)
+	}
+
+	if launchTemplateName == "" {
+		launchTemplateName = d.Get("launch_template_name").(string)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("launch_template_id", launchTemplateID)
+	d.Set("launch_template_name", launchTemplateName)
+	d.Set("version_numbers", versionNumbers)
+	d.Set("versions", versions)
+
+	return diags
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2LaunchTemplateVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_versions.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "launch_template_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "launch_template_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "version_numbers.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_numbers.0", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_numbers.1", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.default_version", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.default_version", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.version_description", "canary"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.1.create_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersionsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionsDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "version_numbers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_numbers.0", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchTemplateVersionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t3.micro"
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id  = aws_launch_template.test.id
+  source_version      = "1"
+  instance_type       = "t3.small"
+  version_description = "canary"
+}
+`, rName)
+}
+
+func testAccLaunchTemplateVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionsDataSourceConfig_base(rName), `
+data "aws_launch_template_versions" "test" {
+  launch_template_name = aws_launch_template.test.name
+
+  depends_on = [aws_launch_template_version.test]
+}
+`)
+}
+
+func testAccLaunchTemplateVersionsDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionsDataSourceConfig_base(rName), `
+data "aws_launch_template_versions" "test" {
+  launch_template_id = aws_launch_template.test.id
+
+  filter {
+    name   = "instance-type"
+    values = ["t3.small"]
+  }
+
+  depends_on = [aws_launch_template_version.test]
+}
+`)
+}
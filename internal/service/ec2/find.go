@@ -1718,6 +1718,189 @@ func FindNetworkInterfaceSecurityGroup(ctx context.Context, conn *ec2.EC2, netwo
 	}
 }
 
+func FindNetworkInsightsAccessScope(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopesInput) (*ec2.NetworkInsightsAccessScope, error) {
+	output, err := FindNetworkInsightsAccessScopes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func FindNetworkInsightsAccessScopes(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopesInput) ([]*ec2.NetworkInsightsAccessScope, error) {
+	var output []*ec2.NetworkInsightsAccessScope
+
+	err := conn.DescribeNetworkInsightsAccessScopesPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkInsightsAccessScopesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInsightsAccessScopes {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInsightsAccessScope, error) {
+	input := &ec2.DescribeNetworkInsightsAccessScopesInput{
+		NetworkInsightsAccessScopeIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindNetworkInsightsAccessScope(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.NetworkInsightsAccessScopeId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeContentByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInsightsAccessScopeContent, error) {
+	input := &ec2.GetNetworkInsightsAccessScopeContentInput{
+		NetworkInsightsAccessScopeId: aws.String(id),
+	}
+
+	output, err := conn.GetNetworkInsightsAccessScopeContentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkInsightsAccessScopeContent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkInsightsAccessScopeContent, nil
+}
+
+func FindNetworkInsightsAccessScopeAnalysis(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopeAnalysesInput) (*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	output, err := FindNetworkInsightsAccessScopeAnalyses(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func FindNetworkInsightsAccessScopeAnalyses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAccessScopeAnalysesInput) ([]*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	var output []*ec2.NetworkInsightsAccessScopeAnalysis
+
+	err := conn.DescribeNetworkInsightsAccessScopeAnalysesPagesWithContext(ctx, input, func(page *ec2.DescribeNetworkInsightsAccessScopeAnalysesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.NetworkInsightsAccessScopeAnalyses {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeAnalysisByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.NetworkInsightsAccessScopeAnalysis, error) {
+	input := &ec2.DescribeNetworkInsightsAccessScopeAnalysesInput{
+		NetworkInsightsAccessScopeAnalysisIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindNetworkInsightsAccessScopeAnalysis(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.NetworkInsightsAccessScopeAnalysisId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindNetworkInsightsAccessScopeAnalysisFindingsByID(ctx context.Context, conn *ec2.EC2, id string) ([]*ec2.AccessScopeAnalysisFinding, error) {
+	input := &ec2.GetNetworkInsightsAccessScopeAnalysisFindingsInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(id),
+	}
+	var output []*ec2.AccessScopeAnalysisFinding
+
+	err := conn.GetNetworkInsightsAccessScopeAnalysisFindingsPagesWithContext(ctx, input, func(page *ec2.GetNetworkInsightsAccessScopeAnalysisFindingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AnalysisFindings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindNetworkInsightsAnalysis(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAnalysesInput) (*ec2.NetworkInsightsAnalysis, error) {
 	output, err := FindNetworkInsightsAnalyses(ctx, conn, input)
 
@@ -6961,6 +7144,38 @@ func FindInstanceStateByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.
 	return instanceState, nil
 }
 
+func FindInstanceStatusByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.InstanceStatus, error) {
+	input := &ec2.DescribeInstanceStatusInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeInstanceStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidInstanceIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceStatuses) == 0 || output.InstanceStatuses[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.InstanceStatuses[0].InstanceId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output.InstanceStatuses[0], nil
+}
+
 func FindInstanceConnectEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeInstanceConnectEndpointsInput) (*awstypes.Ec2InstanceConnectEndpoint, error) {
 	output, err := FindInstanceConnectEndpoints(ctx, conn, input)
 
@@ -284,6 +284,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLaunchTemplate,
 			TypeName: "aws_launch_template",
 		},
+		{
+			Factory:  DataSourceLaunchTemplateVersions,
+			TypeName: "aws_launch_template_versions",
+		},
 		{
 			Factory:  DataSourceNATGateway,
 			TypeName: "aws_nat_gateway",
@@ -604,6 +608,22 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceManagedPrefixListEntry,
 			TypeName: "aws_ec2_managed_prefix_list_entry",
 		},
+		{
+			Factory:  ResourceNetworkInsightsAccessScope,
+			TypeName: "aws_ec2_network_insights_access_scope",
+			Name:     "Network Insights Access Scope",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceNetworkInsightsAccessScopeAnalysis,
+			TypeName: "aws_ec2_network_insights_access_scope_analysis",
+			Name:     "Network Insights Access Scope Analysis",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory:  ResourceNetworkInsightsAnalysis,
 			TypeName: "aws_ec2_network_insights_analysis",
@@ -836,6 +856,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceLaunchTemplateVersion,
+			TypeName: "aws_launch_template_version",
+			Name:     "Launch Template Version",
+		},
 		{
 			Factory:  ResourceMainRouteTableAssociation,
 			TypeName: "aws_main_route_table_association",
@@ -261,6 +261,38 @@ func StatusInstanceState(ctx context.Context, conn *ec2.EC2, id string) retry.St
 	}
 }
 
+// StatusInstanceStatusChecks returns the combined result of an instance's instance and system status checks.
+func StatusInstanceStatusChecks(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInstanceStatusByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var instanceStatus, systemStatus string
+		if output.InstanceStatus != nil {
+			instanceStatus = aws.StringValue(output.InstanceStatus.Status)
+		}
+		if output.SystemStatus != nil {
+			systemStatus = aws.StringValue(output.SystemStatus.Status)
+		}
+
+		switch {
+		case instanceStatus == ec2.SummaryStatusImpaired || systemStatus == ec2.SummaryStatusImpaired:
+			return output, ec2.SummaryStatusImpaired, nil
+		case instanceStatus == ec2.SummaryStatusOk && systemStatus == ec2.SummaryStatusOk:
+			return output, ec2.SummaryStatusOk, nil
+		default:
+			return output, ec2.SummaryStatusInitializing, nil
+		}
+	}
+}
+
 func StatusInstanceCapacityReservationSpecificationEquals(ctx context.Context, conn *ec2.EC2, id string, expectedValue *ec2.CapacityReservationSpecification) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindInstanceByID(ctx, conn, id)
@@ -1139,6 +1171,22 @@ func StatusManagedPrefixListState(ctx context.Context, conn *ec2.EC2, id string)
 	}
 }
 
+func StatusNetworkInsightsAccessScopeAnalysis(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func StatusNetworkInsightsAnalysis(ctx context.Context, conn *ec2.EC2, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindNetworkInsightsAnalysisByID(ctx, conn, id)
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_network_insights_access_scope_analysis", name="Network Insights Access Scope Analysis")
+// @Tags(identifierAttribute="id")
+func ResourceNetworkInsightsAccessScopeAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisCreate,
+		ReadWithoutTimeout:   resourceNetworkInsightsAccessScopeAnalysisRead,
+		UpdateWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisUpdate,
+		DeleteWithoutTimeout: resourceNetworkInsightsAccessScopeAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzed_eni_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_components": networkInsightsAnalysisPathComponentsSchema,
+						"finding_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"findings_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"findings_found": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_insights_access_scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"warning_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.StartNetworkInsightsAccessScopeAnalysisInput{
+		ClientToken:                  aws.String(id.UniqueId()),
+		NetworkInsightsAccessScopeId: aws.String(d.Get("network_insights_access_scope_id").(string)),
+		TagSpecifications:            getTagSpecificationsIn(ctx, ec2.ResourceTypeNetworkInsightsAccessScopeAnalysis),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Network Insights Access Scope Analysis: %s", input)
+	output, err := conn.StartNetworkInsightsAccessScopeAnalysisWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Network Insights Access Scope Analysis: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.NetworkInsightsAccessScopeAnalysis.NetworkInsightsAccessScopeAnalysisId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := WaitNetworkInsightsAccessScopeAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Insights Access Scope Analysis (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	output, err := FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Insights Access Scope Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Insights Access Scope Analysis (%s): %s", d.Id(), err)
+	}
+
+	// Findings are only available once the analysis has completed successfully.
+	var findings []*ec2.AccessScopeAnalysisFinding
+	if aws.StringValue(output.Status) == ec2.AnalysisStatusSucceeded {
+		findings, err = FindNetworkInsightsAccessScopeAnalysisFindingsByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Network Insights Access Scope Analysis (%s) findings: %s", d.Id(), err)
+		}
+	}
+
+	d.Set("analyzed_eni_count", output.AnalyzedEniCount)
+	d.Set("arn", output.NetworkInsightsAccessScopeAnalysisArn)
+	if output.EndDate != nil {
+		d.Set("end_date", aws.TimeValue(output.EndDate).Format(time.RFC3339))
+	} else {
+		d.Set("end_date", nil)
+	}
+	if err := d.Set("findings", flattenAccessScopeAnalysisFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set("findings_count", len(findings))
+	d.Set("findings_found", output.FindingsFound)
+	d.Set("network_insights_access_scope_id", output.NetworkInsightsAccessScopeId)
+	if output.StartDate != nil {
+		d.Set("start_date", aws.TimeValue(output.StartDate).Format(time.RFC3339))
+	} else {
+		d.Set("start_date", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceNetworkInsightsAccessScopeAnalysisRead(ctx, d, meta)
+}
+
+func resourceNetworkInsightsAccessScopeAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Access Scope Analysis: %s", d.Id())
+	_, err := conn.DeleteNetworkInsightsAccessScopeAnalysisWithContext(ctx, &ec2.DeleteNetworkInsightsAccessScopeAnalysisInput{
+		NetworkInsightsAccessScopeAnalysisId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAccessScopeAnalysisIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Network Insights Access Scope Analysis (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func flattenAccessScopeAnalysisFindings(apiObjects []*ec2.AccessScopeAnalysisFinding) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.FindingComponents; v != nil {
+			tfMap["finding_components"] = flattenPathComponents(v)
+		}
+
+		if v := apiObject.FindingId; v != nil {
+			tfMap["finding_id"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "analyzed_eni_count"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`network-insights-access-scope-analysis/.+$`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "end_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "findings_count"),
+					resource.TestCheckResourceAttrSet(resourceName, "findings_found"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_insights_access_scope_id", "aws_ec2_network_insights_access_scope.test", "id"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "start_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", "succeeded"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceNetworkInsightsAccessScopeAnalysis(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScopeAnalysis_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope_analysis.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeAnalysisConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAccessScopeAnalysisExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Access Scope Analysis ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsAccessScopeAnalysisDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_network_insights_access_scope_analysis" {
+				continue
+			}
+
+			_, err := tfec2.FindNetworkInsightsAccessScopeAnalysisByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Network Insights Access Scope Analysis %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_basic() string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeConfig_basic(), `
+resource "aws_ec2_network_insights_access_scope_analysis" "test" {
+  network_insights_access_scope_id = aws_ec2_network_insights_access_scope.test.id
+}
+`)
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeConfig_basic(), fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope_analysis" "test" {
+  network_insights_access_scope_id = aws_ec2_network_insights_access_scope.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccVPCNetworkInsightsAccessScopeAnalysisConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAccessScopeConfig_basic(), fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope_analysis" "test" {
+  network_insights_access_scope_id = aws_ec2_network_insights_access_scope.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkInsightsAccessScope_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexache.MustCompile(`network-insights-access-scope/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "exclude_paths.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "match_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_paths.0.source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_paths.0.source.0.resource_statement.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "match_paths.0.source.0.resource_statement.0.resource_types.*", "AWS::EC2::InternetGateway"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScope_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceNetworkInsightsAccessScope(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScope_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAccessScope_complete(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_access_scope.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAccessScopeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAccessScopeConfig_complete(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkInsightsAccessScopeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_paths.0.source.0.packet_header_statement.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "exclude_paths.0.source.0.packet_header_statement.0.source_addresses.*", "10.0.0.0/16"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "exclude_paths.0.source.0.packet_header_statement.0.protocols.*", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "match_paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_paths.0.destination.0.packet_header_statement.0.destination_ports.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "match_paths.0.through_resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "match_paths.0.through_resources.0.resource_statement.0.resources.*", "aws_vpc.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAccessScopeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Insights Access Scope ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := tfec2.FindNetworkInsightsAccessScopeByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInsightsAccessScopeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_network_insights_access_scope" {
+				continue
+			}
+
+			_, err := tfec2.FindNetworkInsightsAccessScopeByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Network Insights Access Scope %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkInsightsAccessScopeConfig_basic() string {
+	return `
+resource "aws_ec2_network_insights_access_scope" "test" {
+  match_paths {
+    source {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+  }
+}
+`
+}
+
+func testAccVPCNetworkInsightsAccessScopeConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope" "test" {
+  match_paths {
+    source {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccVPCNetworkInsightsAccessScopeConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_network_insights_access_scope" "test" {
+  match_paths {
+    source {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccVPCNetworkInsightsAccessScopeConfig_complete(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_access_scope" "test" {
+  match_paths {
+    source {
+      resource_statement {
+        resource_types = ["AWS::EC2::InternetGateway"]
+      }
+    }
+
+    destination {
+      packet_header_statement {
+        destination_ports = ["22", "3389"]
+        protocols         = ["tcp"]
+      }
+    }
+
+    through_resources {
+      resource_statement {
+        resources = [aws_vpc.test.id]
+      }
+    }
+  }
+
+  exclude_paths {
+    source {
+      packet_header_statement {
+        source_addresses = ["10.0.0.0/16"]
+        protocols        = ["tcp"]
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
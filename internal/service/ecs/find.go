@@ -157,3 +157,43 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+// findStoppedTasksByDeploymentID returns up to 100 of the most recently stopped tasks started by the specified ECS Service deployment.
+func findStoppedTasksByDeploymentID(ctx context.Context, conn *ecs.ECS, cluster, deploymentID string) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		StartedBy:     aws.String(deploymentID),
+	}
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasksWithContext(ctx, describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	return describeOutput.Tasks, nil
+}
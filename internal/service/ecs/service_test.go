@@ -87,6 +87,80 @@ func Test_GetClustereNameFromARN(t *testing.T) {
 	}
 }
 
+func Test_ServiceDeploymentRollout(t *testing.T) {
+	t.Parallel()
+
+	deployment := func(id, status, rolloutState string) *ecs.Deployment {
+		return &ecs.Deployment{
+			Id:           aws.String(id),
+			RolloutState: aws.String(rolloutState),
+			Status:       aws.String(status),
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		deployment            *ecs.Deployment
+		primary               *ecs.Deployment
+		wantFailed            bool
+		wantRolledBack        bool
+		wantRollbackCompleted bool
+	}{
+		{
+			name: "no deployment",
+		},
+		{
+			name:       "in progress",
+			deployment: deployment("ecs-svc/1", "PRIMARY", ecs.DeploymentRolloutStateInProgress),
+			primary:    deployment("ecs-svc/1", "PRIMARY", ecs.DeploymentRolloutStateInProgress),
+		},
+		{
+			name:       "failed without rollback",
+			deployment: deployment("ecs-svc/1", "PRIMARY", ecs.DeploymentRolloutStateFailed),
+			primary:    deployment("ecs-svc/1", "PRIMARY", ecs.DeploymentRolloutStateFailed),
+			wantFailed: true,
+		},
+		{
+			name:           "rollback in progress",
+			deployment:     deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateFailed),
+			primary:        deployment("ecs-svc/2", "PRIMARY", ecs.DeploymentRolloutStateInProgress),
+			wantFailed:     true,
+			wantRolledBack: true,
+		},
+		{
+			name:                  "rollback completed",
+			deployment:            deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateFailed),
+			primary:               deployment("ecs-svc/2", "PRIMARY", ecs.DeploymentRolloutStateCompleted),
+			wantFailed:            true,
+			wantRolledBack:        true,
+			wantRollbackCompleted: true,
+		},
+		{
+			name:       "superseded",
+			deployment: deployment("ecs-svc/1", "ACTIVE", ecs.DeploymentRolloutStateInProgress),
+			primary:    deployment("ecs-svc/2", "PRIMARY", ecs.DeploymentRolloutStateInProgress),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			failed, rolledBack, rollbackCompleted := tfecs.ServiceDeploymentRollout(tt.deployment, tt.primary)
+
+			if failed != tt.wantFailed {
+				t.Errorf("failed = %t, want %t", failed, tt.wantFailed)
+			}
+			if rolledBack != tt.wantRolledBack {
+				t.Errorf("rolledBack = %t, want %t", rolledBack, tt.wantRolledBack)
+			}
+			if rollbackCompleted != tt.wantRollbackCompleted {
+				t.Errorf("rollbackCompleted = %t, want %t", rollbackCompleted, tt.wantRollbackCompleted)
+			}
+		})
+	}
+}
+
 func TestAccECSService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -20,6 +20,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -72,6 +74,9 @@ func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string)
 }
 
 func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
+	// The deployment that was primary when waiting started, i.e. the one being rolled out.
+	var deployment *ecs.Deployment
+
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(ctx, conn, id, cluster)()
 		if err != nil {
@@ -83,6 +88,23 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 		}
 
 		service := serviceRaw.(*ecs.Service)
+		primary := primaryServiceDeployment(service.Deployments)
+
+		// Keep the last observed state of the deployment once a circuit breaker rollback has removed it.
+		if deployment == nil {
+			deployment = primary
+		} else if v := serviceDeploymentByID(service.Deployments, aws.StringValue(deployment.Id)); v != nil {
+			deployment = v
+		}
+
+		if failed, rolledBack, rollbackCompleted := serviceDeploymentRollout(deployment, primary); failed {
+			// Let the rollback settle so that the service is left in a known state.
+			if rolledBack && !rollbackCompleted {
+				return service, serviceStatusPending, nil
+			}
+
+			return service, "", newServiceDeploymentFailedError(ctx, conn, cluster, service, deployment, primary)
+		}
 
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
@@ -96,6 +118,41 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 	}
 }
 
+func primaryServiceDeployment(deployments []*ecs.Deployment) *ecs.Deployment {
+	for _, v := range deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func serviceDeploymentByID(deployments []*ecs.Deployment, id string) *ecs.Deployment {
+	for _, v := range deployments {
+		if aws.StringValue(v.Id) == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// serviceDeploymentRollout reports whether the specified deployment has failed and, if so,
+// whether the deployment circuit breaker has rolled the service back to another (now primary) deployment
+// and whether that rollback has completed.
+func serviceDeploymentRollout(deployment, primary *ecs.Deployment) (failed, rolledBack, rollbackCompleted bool) {
+	if deployment == nil || aws.StringValue(deployment.RolloutState) != ecs.DeploymentRolloutStateFailed {
+		return false, false, false
+	}
+
+	if primary == nil || aws.StringValue(primary.Id) == aws.StringValue(deployment.Id) {
+		return true, false, false
+	}
+
+	return true, true, aws.StringValue(primary.RolloutState) == ecs.DeploymentRolloutStateCompleted
+}
+
 func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &ecs.DescribeTaskSetsInput{
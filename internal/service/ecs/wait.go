@@ -5,11 +5,14 @@ package ecs
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -85,12 +88,93 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
+		// Tasks crash-looping without a deployment circuit breaker never fail the deployment.
+		if tfresource.TimedOut(err) {
+			if deployment := primaryServiceDeployment(v.Deployments); deployment != nil && aws.Int64Value(deployment.FailedTasks) > 0 {
+				tfresource.SetLastError(err, errors.Join(
+					fmt.Errorf("ECS Deployment (%s): %d tasks failed", aws.StringValue(deployment.Id), aws.Int64Value(deployment.FailedTasks)),
+					serviceDeploymentStoppedTasksError(ctx, conn, cluster, deployment),
+				))
+			}
+		}
+
 		return v, err
 	}
 
 	return nil, err
 }
 
+// newServiceDeploymentFailedError returns an error describing why an ECS Service deployment failed,
+// including any circuit breaker rollback, the reasons recently stopped tasks were stopped and recent service events.
+func newServiceDeploymentFailedError(ctx context.Context, conn *ecs.ECS, cluster string, service *ecs.Service, deployment, primary *ecs.Deployment) error {
+	errs := []error{
+		fmt.Errorf("ECS Deployment (%s) failed (%d tasks failed): %s", aws.StringValue(deployment.Id), aws.Int64Value(deployment.FailedTasks), aws.StringValue(deployment.RolloutStateReason)),
+	}
+
+	if _, rolledBack, rollbackCompleted := serviceDeploymentRollout(deployment, primary); rolledBack {
+		state := "in progress"
+		if rollbackCompleted {
+			state = "completed"
+		}
+
+		errs = append(errs, fmt.Errorf("rollback to ECS Deployment (%s) with task definition %s %s", aws.StringValue(primary.Id), aws.StringValue(primary.TaskDefinition), state))
+	}
+
+	errs = append(errs, serviceDeploymentStoppedTasksError(ctx, conn, cluster, deployment))
+
+	// Service events are returned newest first.
+	const (
+		maxEvents = 5
+	)
+	var n int
+	for _, v := range service.Events {
+		if n == maxEvents || aws.TimeValue(v.CreatedAt).Before(aws.TimeValue(deployment.CreatedAt)) {
+			break
+		}
+
+		errs = append(errs, fmt.Errorf("service event: %s", aws.StringValue(v.Message)))
+		n++
+	}
+
+	return errors.Join(errs...)
+}
+
+// serviceDeploymentStoppedTasksError returns an error listing the distinct reasons tasks started by the specified deployment were stopped.
+func serviceDeploymentStoppedTasksError(ctx context.Context, conn *ecs.ECS, cluster string, deployment *ecs.Deployment) error {
+	tasks, err := findStoppedTasksByDeploymentID(ctx, conn, cluster, aws.StringValue(deployment.Id))
+
+	if err != nil {
+		return fmt.Errorf("listing stopped tasks: %w", err)
+	}
+
+	const (
+		maxReasons = 5
+	)
+	var errs []error
+	seen := make(map[string]bool)
+	for _, task := range tasks {
+		reason := aws.StringValue(task.StoppedReason)
+		for _, v := range task.Containers {
+			if v := aws.StringValue(v.Reason); v != "" {
+				reason = fmt.Sprintf("%s: %s", reason, v)
+			}
+		}
+
+		if reason == "" || seen[reason] {
+			continue
+		}
+		seen[reason] = true
+
+		errs = append(errs, fmt.Errorf("stopped task (%s): %s", aws.StringValue(task.TaskArn), reason))
+
+		if len(errs) == maxReasons {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
@@ -20,6 +20,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	// EC2 agent management is accepted by the API but not yet modeled in the AWS SDK.
+	featureAdditionalConfigurationEC2AgentManagement = "EC2_AGENT_MANAGEMENT"
+)
+
+func featureAdditionalConfiguration_Values() []string {
+	return append(guardduty.FeatureAdditionalConfiguration_Values(), featureAdditionalConfigurationEC2AgentManagement)
+}
+
 // @SDKResource("aws_guardduty_detector_feature", name="Detector Feature")
 func ResourceDetectorFeature() *schema.Resource {
 	return &schema.Resource{
@@ -31,7 +40,6 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -39,7 +47,7 @@ func ResourceDetectorFeature() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+							ValidateFunc: validation.StringInSlice(featureAdditionalConfiguration_Values(), false),
 						},
 						"status": {
 							Type:         schema.TypeString,
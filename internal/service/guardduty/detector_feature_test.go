@@ -93,6 +93,49 @@ func testAccDetectorFeature_additionalConfiguration(t *testing.T) {
 	})
 }
 
+func testAccDetectorFeature_runtimeMonitoring(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorFeatureConfig_runtimeMonitoring("ENABLED", "ENABLED", "DISABLED", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.name", "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.name", "EC2_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RUNTIME_MONITORING"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccDetectorFeatureConfig_runtimeMonitoring("ENABLED", "DISABLED", "ENABLED", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDetectorFeature_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource1Name := "aws_guardduty_detector_feature.test1"
@@ -211,6 +254,35 @@ resource "aws_guardduty_detector_feature" "test" {
 `, featureStatus, additionalConfigurationStatus)
 }
 
+func testAccDetectorFeatureConfig_runtimeMonitoring(featureStatus, eksStatus, ecsStatus, ec2Status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "RUNTIME_MONITORING"
+  status      = %[1]q
+
+  additional_configuration {
+    name   = "EKS_ADDON_MANAGEMENT"
+    status = %[2]q
+  }
+
+  additional_configuration {
+    name   = "ECS_FARGATE_AGENT_MANAGEMENT"
+    status = %[3]q
+  }
+
+  additional_configuration {
+    name   = "EC2_AGENT_MANAGEMENT"
+    status = %[4]q
+  }
+}
+`, featureStatus, eksStatus, ecsStatus, ec2Status)
+}
+
 func testAccDetectorFeatureConfig_multiple(status1, status2, status3 string) string {
 	return fmt.Sprintf(`
 resource "aws_guardduty_detector" "test" {
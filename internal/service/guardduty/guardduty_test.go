@@ -31,6 +31,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 		"DetectorFeature": {
 			"basic":                    testAccDetectorFeature_basic,
 			"additional_configuration": testAccDetectorFeature_additionalConfiguration,
+			"runtime_monitoring":       testAccDetectorFeature_runtimeMonitoring,
 			"multiple":                 testAccDetectorFeature_multiple,
 		},
 		"Filter": {
@@ -62,6 +63,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 		"OrganizationConfigurationFeature": {
 			"basic":                    testAccOrganizationConfigurationFeature_basic,
 			"additional_configuration": testAccOrganizationConfigurationFeature_additionalConfiguration,
+			"runtime_monitoring":       testAccOrganizationConfigurationFeature_runtimeMonitoring,
 			"multiple":                 testAccOrganizationConfigurationFeature_multiple,
 		},
 		"ThreatIntelSet": {
@@ -20,6 +20,15 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	// EC2 agent management is accepted by the API but not yet modeled in the AWS SDK.
+	orgFeatureAdditionalConfigurationEC2AgentManagement = "EC2_AGENT_MANAGEMENT"
+)
+
+func orgFeatureAdditionalConfiguration_Values() []string {
+	return append(guardduty.OrgFeatureAdditionalConfiguration_Values(), orgFeatureAdditionalConfigurationEC2AgentManagement)
+}
+
 // @SDKResource("aws_guardduty_organization_configuration_feature", name="Organization Configuration Feature")
 func ResourceOrganizationConfigurationFeature() *schema.Resource {
 	return &schema.Resource{
@@ -31,7 +40,6 @@ func ResourceOrganizationConfigurationFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -44,7 +52,7 @@ func ResourceOrganizationConfigurationFeature() *schema.Resource {
 							Type:         schema.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+							ValidateFunc: validation.StringInSlice(orgFeatureAdditionalConfiguration_Values(), false),
 						},
 					},
 				},
@@ -84,6 +84,50 @@ func testAccOrganizationConfigurationFeature_additionalConfiguration(t *testing.
 	})
 }
 
+func testAccOrganizationConfigurationFeature_runtimeMonitoring(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_guardduty_organization_configuration_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_runtimeMonitoring("NEW", "NEW", "NONE", "NEW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", "EKS_ADDON_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.auto_enable", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.name", "ECS_FARGATE_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.auto_enable", "NEW"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.name", "EC2_AGENT_MANAGEMENT"),
+					resource.TestCheckResourceAttr(resourceName, "name", "RUNTIME_MONITORING"),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationFeatureConfig_runtimeMonitoring("ALL", "ALL", "ALL", "NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationFeatureExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.auto_enable", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.1.auto_enable", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.2.auto_enable", "NONE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOrganizationConfigurationFeature_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource1Name := "aws_guardduty_organization_configuration_feature.test1"
@@ -208,6 +252,33 @@ resource "aws_guardduty_organization_configuration_feature" "test" {
 `, featureAutoEnable, additionalConfigurationAutoEnable))
 }
 
+func testAccOrganizationConfigurationFeatureConfig_runtimeMonitoring(featureAutoEnable, eksAutoEnable, ecsAutoEnable, ec2AutoEnable string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration_feature" "test" {
+  depends_on = [aws_guardduty_organization_configuration.test]
+
+  detector_id = aws_guardduty_detector.test.id
+  name        = "RUNTIME_MONITORING"
+  auto_enable = %[1]q
+
+  additional_configuration {
+    name        = "EKS_ADDON_MANAGEMENT"
+    auto_enable = %[2]q
+  }
+
+  additional_configuration {
+    name        = "ECS_FARGATE_AGENT_MANAGEMENT"
+    auto_enable = %[3]q
+  }
+
+  additional_configuration {
+    name        = "EC2_AGENT_MANAGEMENT"
+    auto_enable = %[4]q
+  }
+}
+`, featureAutoEnable, eksAutoEnable, ecsAutoEnable, ec2AutoEnable))
+}
+
 func testAccOrganizationConfigurationFeatureConfig_multiple(autoEnable1, autoEnable2, autoEnable3 string) string {
 	return acctest.ConfigCompose(testAccOrganizationConfigurationFeatureConfig_base, fmt.Sprintf(`
 resource "aws_guardduty_organization_configuration_feature" "test1" {
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Coverage Statistics")
+func newCoverageStatisticsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &coverageStatisticsDataSource{}, nil
+}
+
+const (
+	DSNameCoverageStatistics = "Coverage Statistics Data Source"
+)
+
+type coverageStatisticsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *coverageStatisticsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_coverage_statistics"
+}
+
+func (d *coverageStatisticsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"counts_by_group": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[countsModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[countsModel](ctx),
+			},
+			"group_by": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GroupKey](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"total_counts": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[coverageFilterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"account_id":              coverageStringFilterBlock(ctx),
+						"ec2_instance_tags":       coverageMapFilterBlock(ctx),
+						"ecr_image_tags":          coverageStringFilterBlock(ctx),
+						"ecr_repository_name":     coverageStringFilterBlock(ctx),
+						"lambda_function_name":    coverageStringFilterBlock(ctx),
+						"lambda_function_runtime": coverageStringFilterBlock(ctx),
+						"lambda_function_tags":    coverageMapFilterBlock(ctx),
+						"last_scanned_at":         coverageDateFilterBlock(ctx),
+						"resource_id":             coverageStringFilterBlock(ctx),
+						"resource_type":           coverageStringFilterBlock(ctx),
+						"scan_status_code":        coverageStringFilterBlock(ctx),
+						"scan_status_reason":      coverageStringFilterBlock(ctx),
+						"scan_type":               coverageStringFilterBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func coverageStringFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[coverageStringFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.CoverageStringComparison](),
+					Required:   true,
+				},
+				"value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func coverageMapFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[coverageMapFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.CoverageMapComparison](),
+					Required:   true,
+				},
+				"key": schema.StringAttribute{
+					Required: true,
+				},
+				"value": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func coverageDateFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[coverageDateFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end_inclusive": schema.StringAttribute{
+					CustomType: fwtypes.TimestampType,
+					Optional:   true,
+				},
+				"start_inclusive": schema.StringAttribute{
+					CustomType: fwtypes.TimestampType,
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+func (d *coverageStatisticsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data coverageStatisticsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Inspector2Client(ctx)
+
+	input := &inspector2.ListCoverageStatisticsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var counts []awstypes.Counts
+	var totalCounts int64
+
+	pages := inspector2.NewListCoverageStatisticsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.Inspector2, create.ErrActionReading, DSNameCoverageStatistics, d.Meta().AccountID, err), err.Error())
+
+			return
+		}
+
+		counts = append(counts, page.CountsByGroup...)
+		// Every page reports the same total.
+		totalCounts = aws.ToInt64(page.TotalCounts)
+	}
+
+	data.ID = types.StringValue(d.Meta().AccountID)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, counts, &data.CountsByGroup)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.TotalCounts = types.Int64Value(totalCounts)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type coverageStatisticsDataSourceModel struct {
+	CountsByGroup  fwtypes.ListNestedObjectValueOf[countsModel]                 `tfsdk:"counts_by_group"`
+	FilterCriteria fwtypes.ListNestedObjectValueOf[coverageFilterCriteriaModel] `tfsdk:"filter_criteria"`
+	GroupBy        fwtypes.StringEnum[awstypes.GroupKey]                        `tfsdk:"group_by"`
+	ID             types.String                                                 `tfsdk:"id"`
+	TotalCounts    types.Int64                                                  `tfsdk:"total_counts"`
+}
+
+type countsModel struct {
+	Count    types.Int64  `tfsdk:"count"`
+	GroupKey types.String `tfsdk:"group_key"`
+}
+
+type coverageFilterCriteriaModel struct {
+	AccountID             fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"account_id"`
+	EC2InstanceTags       fwtypes.ListNestedObjectValueOf[coverageMapFilterModel]    `tfsdk:"ec2_instance_tags"`
+	ECRImageTags          fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"ecr_image_tags"`
+	ECRRepositoryName     fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"ecr_repository_name"`
+	LambdaFunctionName    fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"lambda_function_name"`
+	LambdaFunctionRuntime fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"lambda_function_runtime"`
+	LambdaFunctionTags    fwtypes.ListNestedObjectValueOf[coverageMapFilterModel]    `tfsdk:"lambda_function_tags"`
+	LastScannedAt         fwtypes.ListNestedObjectValueOf[coverageDateFilterModel]   `tfsdk:"last_scanned_at"`
+	ResourceID            fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"resource_id"`
+	ResourceType          fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"resource_type"`
+	ScanStatusCode        fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"scan_status_code"`
+	ScanStatusReason      fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"scan_status_reason"`
+	ScanType              fwtypes.ListNestedObjectValueOf[coverageStringFilterModel] `tfsdk:"scan_type"`
+}
+
+type coverageStringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.CoverageStringComparison] `tfsdk:"comparison"`
+	Value      types.String                                          `tfsdk:"value"`
+}
+
+type coverageMapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.CoverageMapComparison] `tfsdk:"comparison"`
+	Key        types.String                                       `tfsdk:"key"`
+	Value      types.String                                       `tfsdk:"value"`
+}
+
+type coverageDateFilterModel struct {
+	EndInclusive   fwtypes.Timestamp `tfsdk:"end_inclusive"`
+	StartInclusive fwtypes.Timestamp `tfsdk:"start_inclusive"`
+}
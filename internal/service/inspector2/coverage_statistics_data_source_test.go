@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCoverageStatisticsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_inspector2_coverage_statistics.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnablerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoverageStatisticsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "counts_by_group.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "group_by", "RESOURCE_TYPE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_counts"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCoverageStatisticsDataSourceConfig_basic = `
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = ["ECR"]
+}
+
+data "aws_inspector2_coverage_statistics" "test" {
+  group_by = "RESOURCE_TYPE"
+
+  filter_criteria {
+    account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  depends_on = [aws_inspector2_enabler.test]
+}
+`
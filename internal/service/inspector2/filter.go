@@ -0,0 +1,556 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Filter")
+// @Tags(identifierAttribute="arn")
+func newFilterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &filterResource{}, nil
+}
+
+const (
+	ResNameFilter = "Filter"
+)
+
+type filterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *filterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_filter"
+}
+
+func (r *filterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FilterAction](),
+				Required:   true,
+			},
+			"arn": framework.ARNAttributeComputedOnly(),
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_account_id":                     stringFilterBlock(ctx),
+						"code_vulnerability_detector_name":   stringFilterBlock(ctx),
+						"code_vulnerability_detector_tags":   stringFilterBlock(ctx),
+						"code_vulnerability_file_path":       stringFilterBlock(ctx),
+						"component_id":                       stringFilterBlock(ctx),
+						"component_type":                     stringFilterBlock(ctx),
+						"ec2_instance_image_id":              stringFilterBlock(ctx),
+						"ec2_instance_subnet_id":             stringFilterBlock(ctx),
+						"ec2_instance_vpc_id":                stringFilterBlock(ctx),
+						"ecr_image_architecture":             stringFilterBlock(ctx),
+						"ecr_image_hash":                     stringFilterBlock(ctx),
+						"ecr_image_pushed_at":                dateFilterBlock(ctx),
+						"ecr_image_registry":                 stringFilterBlock(ctx),
+						"ecr_image_repository_name":          stringFilterBlock(ctx),
+						"ecr_image_tags":                     stringFilterBlock(ctx),
+						"epss_score":                         numberFilterBlock(ctx),
+						"exploit_available":                  stringFilterBlock(ctx),
+						"finding_arn":                        stringFilterBlock(ctx),
+						"finding_status":                     stringFilterBlock(ctx),
+						"finding_type":                       stringFilterBlock(ctx),
+						"first_observed_at":                  dateFilterBlock(ctx),
+						"fix_available":                      stringFilterBlock(ctx),
+						"inspector_score":                    numberFilterBlock(ctx),
+						"lambda_function_execution_role_arn": stringFilterBlock(ctx),
+						"lambda_function_last_modified_at":   dateFilterBlock(ctx),
+						"lambda_function_layers":             stringFilterBlock(ctx),
+						"lambda_function_name":               stringFilterBlock(ctx),
+						"lambda_function_runtime":            stringFilterBlock(ctx),
+						"last_observed_at":                   dateFilterBlock(ctx),
+						"network_protocol":                   stringFilterBlock(ctx),
+						"port_range":                         portRangeFilterBlock(ctx),
+						"related_vulnerabilities":            stringFilterBlock(ctx),
+						"resource_id":                        stringFilterBlock(ctx),
+						"resource_tags":                      mapFilterBlock(ctx),
+						"resource_type":                      stringFilterBlock(ctx),
+						"severity":                           stringFilterBlock(ctx),
+						"title":                              stringFilterBlock(ctx),
+						"updated_at":                         dateFilterBlock(ctx),
+						"vendor_severity":                    stringFilterBlock(ctx),
+						"vulnerability_id":                   stringFilterBlock(ctx),
+						"vulnerability_source":               stringFilterBlock(ctx),
+						"vulnerable_packages":                packageFilterBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.StringComparison](),
+					Required:   true,
+				},
+				"value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func dateFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dateFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end_inclusive": schema.StringAttribute{
+					CustomType: fwtypes.TimestampType,
+					Optional:   true,
+				},
+				"start_inclusive": schema.StringAttribute{
+					CustomType: fwtypes.TimestampType,
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"lower_inclusive": schema.Float64Attribute{
+					Optional: true,
+				},
+				"upper_inclusive": schema.Float64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func mapFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[mapFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.MapComparison](),
+					Required:   true,
+				},
+				"key": schema.StringAttribute{
+					Required: true,
+				},
+				"value": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func portRangeFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[portRangeFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"begin_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+				"end_inclusive": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func packageFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	singleStringFilterBlock := func() schema.ListNestedBlock {
+		block := stringFilterBlock(ctx)
+		block.Validators = []validator.List{
+			listvalidator.SizeAtMost(1),
+		}
+		return block
+	}
+	singleNumberFilterBlock := numberFilterBlock(ctx)
+	singleNumberFilterBlock.Validators = []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[packageFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"architecture":            singleStringFilterBlock(),
+				"epoch":                   singleNumberFilterBlock,
+				"name":                    singleStringFilterBlock(),
+				"release":                 singleStringFilterBlock(),
+				"source_lambda_layer_arn": singleStringFilterBlock(),
+				"source_layer_hash":       singleStringFilterBlock(),
+				"version":                 singleStringFilterBlock(),
+			},
+		},
+	}
+}
+
+func (r *filterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	input := &inspector2.CreateFilterInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFilter(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Inspector2, create.ErrActionCreating, ResNameFilter, data.Name.ValueString(), err), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *filterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findFilterByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Inspector2, create.ErrActionReading, ResNameFilter, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API returns the criteria under a different name.
+	var criteria filterCriteriaModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Criteria, &criteria)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.FilterCriteria = fwtypes.NewListNestedObjectValueOfPtr(ctx, &criteria)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *filterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.Action.Equal(old.Action) ||
+		!new.Description.Equal(old.Description) ||
+		!new.FilterCriteria.Equal(old.FilterCriteria) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Reason.Equal(old.Reason) {
+		input := &inspector2.UpdateFilterInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FilterArn = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateFilter(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.Inspector2, create.ErrActionUpdating, ResNameFilter, new.ID.ValueString(), err), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *filterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Inspector2, create.ErrActionDeleting, ResNameFilter, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+}
+
+func (r *filterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	output, err := findFilter(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(output.Arn) != arn {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findFilter(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) (*awstypes.Filter, error) {
+	output, err := findFilters(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFilters(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) ([]awstypes.Filter, error) {
+	var output []awstypes.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return output, nil
+}
+
+type filterResourceModel struct {
+	Action         fwtypes.StringEnum[awstypes.FilterAction]            `tfsdk:"action"`
+	ARN            types.String                                         `tfsdk:"arn"`
+	Description    types.String                                         `tfsdk:"description"`
+	FilterCriteria fwtypes.ListNestedObjectValueOf[filterCriteriaModel] `tfsdk:"filter_criteria"`
+	ID             types.String                                         `tfsdk:"id"`
+	Name           types.String                                         `tfsdk:"name"`
+	Reason         types.String                                         `tfsdk:"reason"`
+	Tags           types.Map                                            `tfsdk:"tags"`
+	TagsAll        types.Map                                            `tfsdk:"tags_all"`
+}
+
+func (data *filterResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *filterResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type filterCriteriaModel struct {
+	AWSAccountID                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"aws_account_id"`
+	CodeVulnerabilityDetectorName  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_name"`
+	CodeVulnerabilityDetectorTags  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_tags"`
+	CodeVulnerabilityFilePath      fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_file_path"`
+	ComponentID                    fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_id"`
+	ComponentType                  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_type"`
+	EC2InstanceImageID             fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_image_id"`
+	EC2InstanceSubnetID            fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_subnet_id"`
+	EC2InstanceVPCID               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_vpc_id"`
+	ECRImageArchitecture           fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_architecture"`
+	ECRImageHash                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_hash"`
+	ECRImagePushedAt               fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"ecr_image_pushed_at"`
+	ECRImageRegistry               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_registry"`
+	ECRImageRepositoryName         fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_repository_name"`
+	ECRImageTags                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_tags"`
+	EPSSScore                      fwtypes.ListNestedObjectValueOf[numberFilterModel]    `tfsdk:"epss_score"`
+	ExploitAvailable               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"exploit_available"`
+	FindingARN                     fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_arn"`
+	FindingStatus                  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_status"`
+	FindingType                    fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_type"`
+	FirstObservedAt                fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"first_observed_at"`
+	FixAvailable                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"fix_available"`
+	InspectorScore                 fwtypes.ListNestedObjectValueOf[numberFilterModel]    `tfsdk:"inspector_score"`
+	LambdaFunctionExecutionRoleARN fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_execution_role_arn"`
+	LambdaFunctionLastModifiedAt   fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"lambda_function_last_modified_at"`
+	LambdaFunctionLayers           fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_layers"`
+	LambdaFunctionName             fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_name"`
+	LambdaFunctionRuntime          fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_runtime"`
+	LastObservedAt                 fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"last_observed_at"`
+	NetworkProtocol                fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"network_protocol"`
+	PortRange                      fwtypes.ListNestedObjectValueOf[portRangeFilterModel] `tfsdk:"port_range"`
+	RelatedVulnerabilities         fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"related_vulnerabilities"`
+	ResourceID                     fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_id"`
+	ResourceTags                   fwtypes.ListNestedObjectValueOf[mapFilterModel]       `tfsdk:"resource_tags"`
+	ResourceType                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_type"`
+	Severity                       fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"severity"`
+	Title                          fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"title"`
+	UpdatedAt                      fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"updated_at"`
+	VendorSeverity                 fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vendor_severity"`
+	VulnerabilityID                fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_id"`
+	VulnerabilitySource            fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_source"`
+	VulnerablePackages             fwtypes.ListNestedObjectValueOf[packageFilterModel]   `tfsdk:"vulnerable_packages"`
+}
+
+type stringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.StringComparison] `tfsdk:"comparison"`
+	Value      types.String                                  `tfsdk:"value"`
+}
+
+type dateFilterModel struct {
+	EndInclusive   fwtypes.Timestamp `tfsdk:"end_inclusive"`
+	StartInclusive fwtypes.Timestamp `tfsdk:"start_inclusive"`
+}
+
+type numberFilterModel struct {
+	LowerInclusive types.Float64 `tfsdk:"lower_inclusive"`
+	UpperInclusive types.Float64 `tfsdk:"upper_inclusive"`
+}
+
+type mapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.MapComparison] `tfsdk:"comparison"`
+	Key        types.String                               `tfsdk:"key"`
+	Value      types.String                               `tfsdk:"value"`
+}
+
+type portRangeFilterModel struct {
+	BeginInclusive types.Int64 `tfsdk:"begin_inclusive"`
+	EndInclusive   types.Int64 `tfsdk:"end_inclusive"`
+}
+
+type packageFilterModel struct {
+	Architecture         fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"architecture"`
+	Epoch                fwtypes.ListNestedObjectValueOf[numberFilterModel] `tfsdk:"epoch"`
+	Name                 fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"name"`
+	Release              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"release"`
+	SourceLambdaLayerARN fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_lambda_layer_arn"`
+	SourceLayerHash      fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_layer_hash"`
+	Version              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"version"`
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action", "SUPPRESS"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "inspector2", regexache.MustCompile(`owner/\d{12}/filter/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.aws_account_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.aws_account_id.0.comparison", "EQUALS"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter_criteria.0.aws_account_id.0.value", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "reason", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceFilter, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action", "SUPPRESS"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "0"),
+				),
+			},
+			{
+				Config: testAccFilterConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.0.lower_inclusive", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.inspector_score.0.upper_inclusive", "4.5"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.severity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter_criteria.0.vulnerable_packages.0.name.0.value", "openssl"),
+					resource.TestCheckResourceAttr(resourceName, "reason", "accepted risk"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilter_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Filter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFilterConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFilterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_filter" {
+				continue
+			}
+
+			_, err := tfinspector2.FindFilterByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Inspector2 Filter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFilterExists(ctx context.Context, n string, v *awstypes.Filter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindFilterByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFilterConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_filter" "test" {
+  name        = %[1]q
+  action      = "NONE"
+  description = "updated"
+  reason      = "accepted risk"
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+
+    inspector_score {
+      lower_inclusive = 0
+      upper_inclusive = 4.5
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "LOW"
+    }
+
+    severity {
+      comparison = "EQUALS"
+      value      = "INFORMATIONAL"
+    }
+
+    vulnerable_packages {
+      name {
+        comparison = "EQUALS"
+        value      = "openssl"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccFilterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFilterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_filter" "test" {
+  name   = %[1]q
+  action = "SUPPRESS"
+
+  filter_criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = data.aws_caller_identity.current.account_id
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
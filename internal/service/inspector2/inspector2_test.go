@@ -26,10 +26,19 @@ func TestAccInspector2_serial(t *testing.T) {
 			"memberAccount_updateMemberAccountsAndScanTypes": testAccEnabler_memberAccount_updateMemberAccountsAndScanTypes,
 			"memberAccount_disappearsMemberAssociation":      testAccEnabler_memberAccount_disappearsMemberAssociation,
 		},
+		"CoverageStatisticsDataSource": {
+			"basic": testAccCoverageStatisticsDataSource_basic,
+		},
 		"DelegatedAdminAccount": {
 			"basic":      testAccDelegatedAdminAccount_basic,
 			"disappears": testAccDelegatedAdminAccount_disappears,
 		},
+		"Filter": {
+			"basic":      testAccFilter_basic,
+			"disappears": testAccFilter_disappears,
+			"tags":       testAccFilter_tags,
+			"update":     testAccFilter_update,
+		},
 		"MemberAssociation": {
 			"basic":      testAccMemberAssociation_basic,
 			"disappears": testAccMemberAssociation_disappears,
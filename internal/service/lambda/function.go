@@ -169,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -324,7 +324,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -335,7 +335,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -377,6 +377,23 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -455,6 +472,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			customizeDiffSourceDirSourceCodeHash,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -496,6 +514,24 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		s3Conn := meta.(*conns.AWSClient).S3Client(ctx)
+		location, err := uploadSourceDirPackage(ctx, s3Conn, d, functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+		}
+
+		defer deleteStagedSourceDirPackage(ctx, s3Conn, location)
+
+		input.Code.ZipFile = location.zipFile
+		if location.s3Bucket != "" {
+			input.Code.S3Bucket = aws.String(location.s3Bucket)
+			input.Code.S3Key = aws.String(location.s3Key)
+		}
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -959,6 +995,24 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			s3Conn := meta.(*conns.AWSClient).S3Client(ctx)
+			location, err := uploadSourceDirPackage(ctx, s3Conn, d, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+			}
+
+			defer deleteStagedSourceDirPackage(ctx, s3Conn, location)
+
+			input.ZipFile = location.zipFile
+			if location.s3Bucket != "" {
+				input.S3Bucket = aws.String(location.s3Bucket)
+				input.S3Key = aws.String(location.s3Key)
+			}
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -1283,6 +1337,7 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
+		d.HasChange("source_dir") ||
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
@@ -1902,6 +1902,58 @@ func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	sourceDir := t.TempDir()
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func.js", sourceDir, "lambda.js")
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func_modified.js", sourceDir, "excluded/lambda.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", sourceDir),
+					resource.TestCheckResourceAttr(resourceName, "source_dir_excludes.#", "1"),
+					testAccCheckFunctionSourceCodeHashAttr(resourceName, &conf),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_dir_excludes"},
+			},
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func_modified.js", sourceDir, "lambda.js")
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionSourceCodeHashAttr(resourceName, &conf),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2437,6 +2489,31 @@ func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash
 	}
 }
 
+// testAccCheckFunctionSourceCodeHashAttr checks that the planned source_code_hash matches the deployed code.
+func testAccCheckFunctionSourceCodeHashAttr(name string, function *lambda.GetFunctionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(name, "source_code_hash", aws.ToString(function.Configuration.CodeSha256))(s)
+	}
+}
+
+func testAccCopySourceDirFile(t *testing.T, src, dir, name string) {
+	t.Helper()
+
+	content, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key string, before time.Time) error {
 	rs, ok := s.RootModule().Resources[name]
 	if !ok {
@@ -3823,6 +3900,21 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir          = %[1]q
+  source_dir_excludes = ["excluded/**"]
+  function_name       = %[2]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "lambda.handler"
+  runtime             = "nodejs16.x"
+}
+`, sourceDir, rName))
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
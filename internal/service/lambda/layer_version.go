@@ -76,7 +76,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -97,19 +97,19 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -135,11 +135,32 @@ func ResourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffSourceDirSourceCodeHash,
 	}
 }
 
@@ -149,12 +170,13 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 
 	layerName := d.Get("layer_name").(string)
 	filename, hasFilename := d.GetOk("filename")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 	s3Bucket, bucketOk := d.GetOk("s3_bucket")
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *lambda.LayerVersionContentInput
@@ -168,6 +190,29 @@ func resourceLayerVersionPublish(ctx context.Context, d *schema.ResourceData, me
 		layerContent = &lambda.LayerVersionContentInput{
 			ZipFile: file,
 		}
+	} else if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		s3Conn := meta.(*conns.AWSClient).S3Client(ctx)
+		location, err := uploadSourceDirPackage(ctx, s3Conn, d, layerName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", sourceDir.(string), err)
+		}
+
+		defer deleteStagedSourceDirPackage(ctx, s3Conn, location)
+
+		if location.s3Bucket != "" {
+			layerContent = &lambda.LayerVersionContentInput{
+				S3Bucket: aws.String(location.s3Bucket),
+				S3Key:    aws.String(location.s3Key),
+			}
+		} else {
+			layerContent = &lambda.LayerVersionContentInput{
+				ZipFile: location.zipFile,
+			}
+		}
 	} else {
 		if !bucketOk || !keyOk {
 			return sdkdiag.AppendErrorf(diags, "s3_bucket and s3_key must all be set while using s3 code source")
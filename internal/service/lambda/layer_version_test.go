@@ -126,6 +126,49 @@ func TestAccLambdaLayerVersion_sourceCodeHash(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func.js", sourceDir, "nodejs/node_modules/example/index.js")
+				},
+				Config: testAccLayerVersionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy", "source_dir"},
+			},
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func_modified.js", sourceDir, "nodejs/node_modules/example/index.js")
+				},
+				Config: testAccLayerVersionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "lambda", fmt.Sprintf("layer:%s:2", rName)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -385,6 +428,15 @@ resource "aws_lambda_layer_version" "test" {
 `, rName)
 }
 
+func testAccLayerVersionConfig_sourceDir(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  source_dir = %[2]q
+  layer_name = %[1]q
+}
+`, rName, sourceDir)
+}
+
 func testAccLayerVersionConfig_s3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "lambda_bucket" {
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly to Lambda.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceDirMaxDirectUploadSize = 50 * 1024 * 1024
+)
+
+var (
+	// Modification time of all entries in a deployment package built from a source directory.
+	// The MS-DOS epoch is the earliest time representable in a ZIP file.
+	sourceDirModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceDirPackage is a deployment package built from a local source directory.
+type sourceDirPackage struct {
+	zipFile []byte
+	// Base64-encoded SHA-256 hash of the package, as returned by Lambda.
+	sourceCodeHash string
+}
+
+func sourceDirPackageFromResource(d interface{ Get(string) any }) (*sourceDirPackage, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buildSourceDirPackage(sourceDir, flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+}
+
+// buildSourceDirPackage builds a deployment package containing the files in the local directory `sourceDir`,
+// other than those whose `/`-separated path relative to `sourceDir` matches any of the `excludes` glob patterns.
+// The package is reproducible: entries are sorted by path and have a fixed modification time and permissions.
+// Executable files have mode 0755 and all other files mode 0644.
+func buildSourceDirPackage(sourceDir string, excludes []string) (*sourceDirPackage, error) {
+	excludeRes := tfslices.ApplyToAll(excludes, glob.Compile)
+
+	type file struct {
+		path       string
+		name       string
+		executable bool
+	}
+	var files []file
+
+	err := filepath.WalkDir(sourceDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		name, err := filepath.Rel(sourceDir, path)
+
+		if err != nil {
+			return err
+		}
+
+		name = filepath.ToSlash(name)
+
+		if glob.MatchAny(excludeRes, name) {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(path)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		files = append(files, file{
+			path:       path,
+			name:       name,
+			executable: info.Mode().Perm()&0o100 != 0,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", sourceDir, err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source directory (%s) contains no files", sourceDir)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, v := range files {
+		header := &zip.FileHeader{
+			Name:     v.name,
+			Method:   zip.Deflate,
+			Modified: sourceDirModified,
+		}
+		if v.executable {
+			header.SetMode(0o755)
+		} else {
+			header.SetMode(0o644)
+		}
+
+		if err := addSourceDirPackageFile(w, header, v.path); err != nil {
+			return nil, fmt.Errorf("adding %s to deployment package: %w", v.path, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+
+	return &sourceDirPackage{
+		zipFile:        buf.Bytes(),
+		sourceCodeHash: base64.StdEncoding.EncodeToString(hash[:]),
+	}, nil
+}
+
+func addSourceDirPackageFile(w *zip.Writer, header *zip.FileHeader, path string) error {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	entry, err := w.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(entry, f)
+
+	return err
+}
+
+// customizeDiffSourceDirSourceCodeHash sets the planned value of `source_code_hash` from the deployment package built from `source_dir`.
+func customizeDiffSourceDirSourceCodeHash(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("source_dir"); !ok || v.(string) == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	pkg, err := sourceDirPackageFromResource(d)
+
+	if err != nil {
+		return fmt.Errorf("packaging source_dir: %w", err)
+	}
+
+	if d.Get("source_code_hash").(string) == pkg.sourceCodeHash {
+		return nil
+	}
+
+	return d.SetNew("source_code_hash", pkg.sourceCodeHash)
+}
+
+// sourceDirPackageLocation is where a deployment package built from a source directory is uploaded.
+// Packages larger than the direct upload limit are staged in S3.
+type sourceDirPackageLocation struct {
+	zipFile  []byte
+	s3Bucket string
+	s3Key    string
+}
+
+// uploadSourceDirPackage returns the location of the deployment package built from `source_dir`,
+// staging the package in the `source_dir_s3_bucket` S3 bucket if it exceeds the direct upload limit.
+func uploadSourceDirPackage(ctx context.Context, conn *s3.Client, d *schema.ResourceData, name string) (*sourceDirPackageLocation, error) {
+	pkg, err := sourceDirPackageFromResource(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkg.zipFile) <= sourceDirMaxDirectUploadSize {
+		return &sourceDirPackageLocation{
+			zipFile: pkg.zipFile,
+		}, nil
+	}
+
+	bucket := d.Get("source_dir_s3_bucket").(string)
+
+	if bucket == "" {
+		return nil, fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes), set source_dir_s3_bucket to stage it in S3", len(pkg.zipFile), sourceDirMaxDirectUploadSize)
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(pkg.sourceCodeHash)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(hash))
+
+	log.Printf("[DEBUG] Staging Lambda deployment package in S3 Bucket (%s) Object (%s)", bucket, key)
+	_, err = manager.NewUploader(conn).Upload(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(pkg.zipFile),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("staging deployment package in S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return &sourceDirPackageLocation{
+		s3Bucket: bucket,
+		s3Key:    key,
+	}, nil
+}
+
+// deleteStagedSourceDirPackage deletes any deployment package staged in S3.
+// Lambda keeps its own copy of the package so the staged object is no longer needed.
+func deleteStagedSourceDirPackage(ctx context.Context, conn *s3.Client, location *sourceDirPackageLocation) {
+	if location == nil || location.s3Bucket == "" {
+		return
+	}
+
+	_, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(location.s3Bucket),
+		Key:    aws.String(location.s3Key),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Deleting staged Lambda deployment package (s3://%s/%s): %s", location.s3Bucket, location.s3Key, err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestBuildSourceDirPackage(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFile(t, dir, "index.js", "exports.handler = async () => 'ok';", 0o600)
+	writeSourceDirFile(t, dir, "bootstrap", "#!/bin/sh", 0o700)
+	writeSourceDirFile(t, dir, "lib/util.js", "module.exports = {};", 0o664)
+	writeSourceDirFile(t, dir, "lib/util.test.js", "test();", 0o644)
+	writeSourceDirFile(t, dir, ".git/HEAD", "ref: refs/heads/main", 0o644)
+
+	pkg, err := buildSourceDirPackage(dir, []string{".git/**", "**/*.test.js"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg.zipFile), int64(len(pkg.zipFile)))
+
+	if err != nil {
+		t.Fatalf("reading package: %s", err)
+	}
+
+	expected := []struct {
+		name    string
+		mode    os.FileMode
+		content string
+	}{
+		{name: "bootstrap", mode: 0o755, content: "#!/bin/sh"},
+		{name: "index.js", mode: 0o644, content: "exports.handler = async () => 'ok';"},
+		{name: "lib/util.js", mode: 0o644, content: "module.exports = {};"},
+	}
+
+	if got, want := len(r.File), len(expected); got != want {
+		t.Fatalf("got %d entries, expected %d", got, want)
+	}
+
+	for i, f := range r.File {
+		if got, want := f.Name, expected[i].name; got != want {
+			t.Errorf("entry %d: got name %q, expected %q", i, got, want)
+		}
+		// Windows does not have executable permission bits.
+		if runtime.GOOS != "windows" {
+			if got, want := f.Mode().Perm(), expected[i].mode; got != want {
+				t.Errorf("entry %s: got mode %s, expected %s", f.Name, got, want)
+			}
+		}
+		if got, want := f.Modified.UTC(), sourceDirModified; !got.Equal(want) {
+			t.Errorf("entry %s: got modification time %s, expected %s", f.Name, got, want)
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("opening entry %s: %s", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("reading entry %s: %s", f.Name, err)
+		}
+
+		if got, want := string(content), expected[i].content; got != want {
+			t.Errorf("entry %s: got content %q, expected %q", f.Name, got, want)
+		}
+	}
+}
+
+func TestBuildSourceDirPackage_reproducible(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFile(t, dir, "index.js", "exports.handler = async () => 'ok';", 0o644)
+	writeSourceDirFile(t, dir, "lib/util.js", "module.exports = {};", 0o644)
+
+	pkg1, err := buildSourceDirPackage(dir, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Modification times and non-executable permission bits do not affect the package.
+	mtime := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(filepath.Join(dir, "lib", "util.js"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg2, err := buildSourceDirPackage(dir, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pkg1.sourceCodeHash != pkg2.sourceCodeHash {
+		t.Errorf("got different hashes %q and %q", pkg1.sourceCodeHash, pkg2.sourceCodeHash)
+	}
+
+	writeSourceDirFile(t, dir, "index.js", "exports.handler = async () => 'changed';", 0o644)
+
+	pkg3, err := buildSourceDirPackage(dir, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if pkg1.sourceCodeHash == pkg3.sourceCodeHash {
+		t.Errorf("got same hash %q after content change", pkg1.sourceCodeHash)
+	}
+}
+
+func TestBuildSourceDirPackage_empty(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeSourceDirFile(t, dir, "README.md", "# Readme", 0o644)
+
+	if _, err := buildSourceDirPackage(dir, []string{"*.md"}); err == nil {
+		t.Error("expected error, got none")
+	}
+
+	if _, err := buildSourceDirPackage(filepath.Join(dir, "missing"), nil); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func writeSourceDirFile(t *testing.T, dir, name, content string, mode os.FileMode) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+
+	// Not affected by umask.
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatal(err)
+	}
+}
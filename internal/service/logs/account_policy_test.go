@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsAccountPolicy_serial(t *testing.T) {
+	t.Parallel()
+
+	// Only one account policy of each type can exist in an account.
+	testCases := map[string]func(t *testing.T){
+		"basic":          testAccAccountPolicy_basic,
+		"disappears":     testAccAccountPolicy_disappears,
+		"policyDocument": testAccAccountPolicy_policyDocument,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy types.AccountPolicy
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_basic(rName, "EmailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+					resource.TestCheckResourceAttr(resourceName, "policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "DATA_PROTECTION_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAccountPolicyImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccountPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy types.AccountPolicy
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_basic(rName, "EmailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceAccountPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAccountPolicy_policyDocument(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy types.AccountPolicy
+	resourceName := "aws_cloudwatch_log_account_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchLogsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountPolicyConfig_basic(rName, "EmailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+				),
+			},
+			{
+				Config: testAccAccountPolicyConfig_basic(rName, "DriversLicense-US"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_document"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_account_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, types.PolicyType(rs.Primary.Attributes["policy_type"]), rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Account Policy still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountPolicyExists(ctx context.Context, n string, v *types.AccountPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindAccountPolicyByTwoPartKey(ctx, conn, types.PolicyType(rs.Primary.Attributes["policy_type"]), rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAccountPolicyImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.ID, rs.Primary.Attributes["policy_type"]), nil
+	}
+}
+
+func testAccAccountPolicyConfig_basic(rName, dataIdentifier string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_account_policy" "test" {
+  policy_name = %[1]q
+  policy_type = "DATA_PROTECTION_POLICY"
+
+  policy_document = jsonencode({
+    Name    = "Test"
+    Version = "2021-06-01"
+
+    Statement = [
+      {
+        Sid            = "Audit"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/%[2]s"]
+        Operation = {
+          Audit = {
+            FindingsDestination = {}
+          }
+        }
+      },
+      {
+        Sid            = "Redact"
+        DataIdentifier = ["arn:${data.aws_partition.current.partition}:dataprotection::aws:data-identifier/%[2]s"]
+        Operation = {
+          Deidentify = {
+            MaskConfig = {}
+          }
+        }
+      }
+    ]
+  })
+}
+`, rName, dataIdentifier)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_osis_pipeline", name="Pipeline")
+func dataSourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePipelineRead,
+
+		Schema: map[string]*schema.Schema{
+			"buffer_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"persistent_buffer_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"encryption_at_rest_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ingest_endpoint_urls": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_publishing_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_destination": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"is_logging_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pipeline_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pipeline_configuration_body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pipeline_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchIngestionClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("pipeline_name").(string)
+	pipeline, err := findPipelineByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Ingestion Pipeline (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(pipeline.PipelineName))
+	if pipeline.BufferOptions != nil {
+		if err := d.Set("buffer_options", []interface{}{flattenBufferOptions(pipeline.BufferOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting buffer_options: %s", err)
+		}
+	} else {
+		d.Set("buffer_options", nil)
+	}
+	if pipeline.EncryptionAtRestOptions != nil {
+		if err := d.Set("encryption_at_rest_options", []interface{}{flattenEncryptionAtRestOptions(pipeline.EncryptionAtRestOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting encryption_at_rest_options: %s", err)
+		}
+	} else {
+		d.Set("encryption_at_rest_options", nil)
+	}
+	d.Set("ingest_endpoint_urls", pipeline.IngestEndpointUrls)
+	if pipeline.LogPublishingOptions != nil {
+		if err := d.Set("log_publishing_options", []interface{}{flattenLogPublishingOptions(pipeline.LogPublishingOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting log_publishing_options: %s", err)
+		}
+	} else {
+		d.Set("log_publishing_options", nil)
+	}
+	d.Set("max_units", pipeline.MaxUnits)
+	d.Set("min_units", pipeline.MinUnits)
+	d.Set("pipeline_arn", pipeline.PipelineArn)
+	d.Set("pipeline_configuration_body", pipeline.PipelineConfigurationBody)
+	d.Set("pipeline_name", pipeline.PipelineName)
+	d.Set("status", pipeline.Status)
+	if len(pipeline.VpcEndpoints) > 0 && pipeline.VpcEndpoints[0].VpcOptions != nil {
+		if err := d.Set("vpc_options", []interface{}{flattenVPCOptions(pipeline.VpcEndpoints[0].VpcOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
+		}
+	} else {
+		d.Set("vpc_options", nil)
+	}
+
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, pipeline.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchIngestionPipelineDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("%s-%s", acctest.ResourcePrefix, sdkacctest.RandString(10))
+	dataSourceName := "data.aws_osis_pipeline.test"
+	resourceName := "aws_osis_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchIngestionEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "ingest_endpoint_urls.#", resourceName, "ingest_endpoint_urls.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_units", resourceName, "max_units"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "min_units", resourceName, "min_units"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "pipeline_arn", resourceName, "pipeline_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "pipeline_name", resourceName, "pipeline_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_tags1(rName, "key1", "value1"), `
+data "aws_osis_pipeline" "test" {
+  pipeline_name = aws_osis_pipeline.test.pipeline_name
+}
+`)
+}
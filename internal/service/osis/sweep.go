@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package osis
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/osis"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_osis_pipeline", &resource.Sweeper{
+		Name: "aws_osis_pipeline",
+		F:    sweepPipelines,
+	})
+}
+
+func sweepPipelines(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.OpenSearchIngestionClient(ctx)
+	input := &osis.ListPipelinesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := osis.NewListPipelinesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping OpenSearch Ingestion Pipeline sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("listing OpenSearch Ingestion Pipelines (%s): %w", region, err)
+		}
+
+		for _, v := range page.Pipelines {
+			r := resourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping OpenSearch Ingestion Pipelines (%s): %w", region, err)
+	}
+
+	return nil
+}
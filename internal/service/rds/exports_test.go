@@ -5,7 +5,10 @@ package rds
 
 // Exports for use in tests only.
 var (
-	FindDBInstanceByID = findDBInstanceByIDSDKv1
+	ResourceIntegration = newIntegrationResource
+
+	FindDBInstanceByID   = findDBInstanceByIDSDKv1
+	FindIntegrationByARN = findIntegrationByARN
 
 	ListTags = listTags
 
@@ -16,6 +19,10 @@ var (
 
 	ParseDBInstanceARN = parseDBInstanceARN
 
+	FlattenPendingModifiedValues       = flattenPendingModifiedValues
+	IsStorageModificationCooldownError = isStorageModificationCooldownError
+	SuppressPendingModifiedValue       = suppressPendingModifiedValue
+
 	WaitDBInstanceAvailable = waitDBInstanceAvailableSDKv2
 	WaitDBInstanceDeleted   = waitDBInstanceDeleted
 )
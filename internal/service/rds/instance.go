@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -110,7 +111,7 @@ func ResourceInstance() *schema.Resource {
 						return true
 					}
 
-					return false
+					return suppressPendingModifiedValue(k, old, new, d)
 				},
 			},
 			"allow_major_version_upgrade": {
@@ -141,10 +142,11 @@ func ResourceInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"backup_retention_period": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IntBetween(0, 35),
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntBetween(0, 35),
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"backup_target": {
 				Type:         schema.TypeString,
@@ -173,9 +175,10 @@ func ResourceInstance() *schema.Resource {
 				},
 			},
 			"ca_cert_identifier": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"character_set_name": {
 				Type:     schema.TypeString,
@@ -208,9 +211,10 @@ func ResourceInstance() *schema.Resource {
 				},
 			},
 			"db_subnet_group_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"delete_automated_backups": {
 				Type:     schema.TypeBool,
@@ -252,9 +256,10 @@ func ResourceInstance() *schema.Resource {
 				},
 			},
 			"engine_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"engine_version_actual": {
 				Type:     schema.TypeString,
@@ -275,15 +280,17 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"iam_database_authentication_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"identifier": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{"identifier_prefix"},
-				ValidateFunc:  validIdentifier,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"identifier_prefix"},
+				ValidateFunc:     validIdentifier,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"identifier_prefix": {
 				Type:          schema.TypeString,
@@ -293,13 +300,15 @@ func ResourceInstance() *schema.Resource {
 				ValidateFunc:  validIdentifierPrefix,
 			},
 			"instance_class": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"iops": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"kms_key_id": {
 				Type:         schema.TypeString,
@@ -313,9 +322,10 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"license_model": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"listener_endpoint": {
 				Type:     schema.TypeList,
@@ -404,9 +414,10 @@ func ResourceInstance() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"multi_az": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"nchar_character_set_name": {
 				Type:     schema.TypeString,
@@ -436,6 +447,11 @@ func ResourceInstance() *schema.Resource {
 				Sensitive:     true,
 				ConflictsWith: []string{"manage_master_user_password"},
 			},
+			"pending_modified_values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -453,9 +469,10 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"port": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"publicly_accessible": {
 				Type:     schema.TypeBool,
@@ -578,14 +595,16 @@ func ResourceInstance() *schema.Resource {
 				ForceNew: true,
 			},
 			"storage_throughput": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			"storage_type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressPendingModifiedValue,
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -1746,6 +1765,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if len(v.DBParameterGroups) > 0 && v.DBParameterGroups[0] != nil {
 		d.Set("parameter_group_name", v.DBParameterGroups[0].DBParameterGroupName)
 	}
+	d.Set("pending_modified_values", flattenPendingModifiedValues(v.PendingModifiedValues))
 	d.Set("performance_insights_enabled", v.PerformanceInsightsEnabled)
 	d.Set("performance_insights_kms_key_id", v.PerformanceInsightsKMSKeyId)
 	d.Set("performance_insights_retention_period", v.PerformanceInsightsRetentionPeriod)
@@ -1998,6 +2018,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 			dbInstancePopulateModify(input, d)
 
+			// Storage can't be modified while a previous storage change is being optimized.
+			if input.AllocatedStorage != nil || input.Iops != nil || input.StorageThroughput != nil || input.StorageType != nil {
+				if _, err := waitDBInstanceStorageOptimizedSDKv2(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): waiting for storage optimization: %s", d.Get("identifier").(string), err)
+				}
+			}
+
 			if d.HasChange("engine_version") {
 				input.EngineVersion = aws.String(d.Get("engine_version").(string))
 				input.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
@@ -2256,6 +2283,10 @@ func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, resourceID st
 				return true, err
 			}
 
+			if isStorageModificationCooldownError(err, input) {
+				return true, err
+			}
+
 			if errs.IsA[*types.InvalidDBClusterStateFault](err) {
 				return true, err
 			}
@@ -2263,6 +2294,9 @@ func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, resourceID st
 			return false, err
 		},
 	)
+	if isStorageModificationCooldownError(err, input) {
+		return fmt.Errorf("storage can't be modified until storage optimization completes and 6 hours have passed since the previous storage modification; retry later or increase the update timeout: %w", err)
+	}
 	if err != nil {
 		return err
 	}
@@ -2273,6 +2307,19 @@ func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, resourceID st
 	return nil
 }
 
+// isStorageModificationCooldownError returns whether the error indicates that storage was modified too recently to be modified again.
+// RDS requires at least 6 hours between storage modifications and refuses a storage modification within that time
+// with an InvalidParameterCombination error about the instance's storage.
+func isStorageModificationCooldownError(err error, input *rds_sdkv2.ModifyDBInstanceInput) bool {
+	if input == nil || (input.AllocatedStorage == nil && input.Iops == nil && input.StorageThroughput == nil && input.StorageType == nil) {
+		return false
+	}
+
+	var apiErr smithy.APIError
+
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == errCodeInvalidParameterCombination && strings.Contains(strings.ToLower(apiErr.ErrorMessage()), "storage")
+}
+
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
@@ -2651,6 +2698,45 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 	return nil, err
 }
 
+// waitDBInstanceStorageOptimizedSDKv2 waits for any storage optimization following a previous storage change to complete.
+// Storage optimization can take several hours, during which the instance's storage can't be modified.
+func waitDBInstanceStorageOptimizedSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
+	options := tfresource.Options{
+		PollInterval: 30 * time.Second,
+	}
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusConfiguringEnhancedMonitoring,
+			InstanceStatusConfiguringIAMDatabaseAuth,
+			InstanceStatusConfiguringLogExports,
+			InstanceStatusMaintenance,
+			InstanceStatusModifying,
+			InstanceStatusRebooting,
+			InstanceStatusStarting,
+			InstanceStatusStorageFull,
+			InstanceStatusStorageOptimization,
+			InstanceStatusUpgrading,
+		},
+		Target:  []string{InstanceStatusAvailable},
+		Refresh: statusDBInstanceSDKv2(ctx, conn, id),
+		Timeout: timeout,
+	}
+	options.Apply(stateConf)
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
@@ -2850,3 +2936,79 @@ func flattenEndpoint(apiObject *rds.Endpoint) map[string]interface{} {
 
 	return tfMap
 }
+
+// suppressPendingModifiedValue suppresses the difference for an attribute whose configured value matches
+// a modification pending for the next maintenance window.
+func suppressPendingModifiedValue(k, old, new string, d *schema.ResourceData) bool {
+	if v, ok := d.Get("pending_modified_values").(map[string]interface{})[k]; ok {
+		return v.(string) == new
+	}
+
+	return false
+}
+
+func flattenPendingModifiedValues(apiObject *rds.PendingModifiedValues) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AllocatedStorage; v != nil {
+		tfMap["allocated_storage"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.BackupRetentionPeriod; v != nil {
+		tfMap["backup_retention_period"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.CACertificateIdentifier; v != nil {
+		tfMap["ca_cert_identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DBInstanceClass; v != nil {
+		tfMap["instance_class"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DBInstanceIdentifier; v != nil {
+		tfMap["identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DBSubnetGroupName; v != nil {
+		tfMap["db_subnet_group_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EngineVersion; v != nil {
+		tfMap["engine_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IAMDatabaseAuthenticationEnabled; v != nil {
+		tfMap["iam_database_authentication_enabled"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+
+	if v := apiObject.Iops; v != nil {
+		tfMap["iops"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.LicenseModel; v != nil {
+		tfMap["license_model"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.MultiAZ; v != nil {
+		tfMap["multi_az"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+
+	if v := apiObject.Port; v != nil {
+		tfMap["port"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.StorageThroughput; v != nil {
+		tfMap["storage_throughput"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.StorageType; v != nil {
+		tfMap["storage_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
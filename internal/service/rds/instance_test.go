@@ -12,10 +12,12 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/smithy-go"
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -80,6 +82,7 @@ func TestAccRDSInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "max_allocated_storage", "0"),
 					resource.TestMatchResourceAttr(resourceName, "option_group_name", regexache.MustCompile(`^default:mysql-\d`)),
 					resource.TestMatchResourceAttr(resourceName, "parameter_group_name", regexache.MustCompile(`^default\.mysql\d`)),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "port", "3306"),
 					resource.TestCheckResourceAttr(resourceName, "publicly_accessible", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
@@ -108,6 +111,143 @@ func TestAccRDSInstance_basic(t *testing.T) {
 	})
 }
 
+func TestSuppressPendingModifiedValue(t *testing.T) {
+	t.Parallel()
+
+	d := tfrds.ResourceInstance().TestResourceData()
+	if err := d.Set("pending_modified_values", tfrds.FlattenPendingModifiedValues(&rds.PendingModifiedValues{
+		BackupRetentionPeriod: aws.Int64(0),
+		DBInstanceClass:       aws.String("db.t3.small"),
+		DBInstanceIdentifier:  aws.String("renamed"),
+		EngineVersion:         aws.String("8.0.35"),
+		MultiAZ:               aws.Bool(true),
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		key  string
+		old  string
+		new  string
+		want bool
+	}{
+		{key: "instance_class", old: "db.t3.micro", new: "db.t3.small", want: true},
+		{key: "instance_class", old: "db.t3.micro", new: "db.t3.medium", want: false},
+		{key: "backup_retention_period", old: "7", new: "0", want: true},
+		{key: "multi_az", old: "false", new: "true", want: true},
+		{key: "allocated_storage", old: "10", new: "20", want: false},
+		{key: "engine_version", old: "8.0.34", new: "8.0.35", want: true},
+		{key: "engine_version", old: "8.0.34", new: "8.0.36", want: false},
+		{key: "identifier", old: "original", new: "renamed", want: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfrds.SuppressPendingModifiedValue(testCase.key, testCase.old, testCase.new, d); got != testCase.want {
+			t.Errorf("SuppressPendingModifiedValue(%q, %q, %q) = %t, want %t", testCase.key, testCase.old, testCase.new, got, testCase.want)
+		}
+	}
+}
+
+func TestIsStorageModificationCooldownError(t *testing.T) {
+	t.Parallel()
+
+	storageInput := &rds_sdkv2.ModifyDBInstanceInput{AllocatedStorage: aws_sdkv2.Int32(100)}
+	cooldownErr := &smithy.GenericAPIError{Code: "InvalidParameterCombination", Message: "You can't modify the storage of this DB instance because 6 hours haven't passed since the previous storage modification."}
+
+	testCases := map[string]struct {
+		err   error
+		input *rds_sdkv2.ModifyDBInstanceInput
+		want  bool
+	}{
+		"no error": {
+			input: storageInput,
+		},
+		"storage cooldown": {
+			err:   cooldownErr,
+			input: storageInput,
+			want:  true,
+		},
+		"reworded storage cooldown": {
+			err:   &smithy.GenericAPIError{Code: "InvalidParameterCombination", Message: "Storage was modified too recently. Try again later."},
+			input: &rds_sdkv2.ModifyDBInstanceInput{StorageType: aws.String("gp3")},
+			want:  true,
+		},
+		"iops": {
+			err:   cooldownErr,
+			input: &rds_sdkv2.ModifyDBInstanceInput{Iops: aws_sdkv2.Int32(3000)},
+			want:  true,
+		},
+		"storage throughput": {
+			err:   cooldownErr,
+			input: &rds_sdkv2.ModifyDBInstanceInput{StorageThroughput: aws_sdkv2.Int32(125)},
+			want:  true,
+		},
+		"no storage modification": {
+			err:   cooldownErr,
+			input: &rds_sdkv2.ModifyDBInstanceInput{DBInstanceClass: aws.String("db.t3.small")},
+		},
+		"unrelated error": {
+			err:   &smithy.GenericAPIError{Code: "InvalidParameterCombination", Message: "The parameter group can't be used with this engine version."},
+			input: storageInput,
+		},
+		"other error code": {
+			err:   &smithy.GenericAPIError{Code: "InvalidParameterValue", Message: "Invalid storage size."},
+			input: storageInput,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfrds.IsStorageModificationCooldownError(testCase.err, testCase.input); got != testCase.want {
+				t.Errorf("IsStorageModificationCooldownError(%v) = %t, want %t", testCase.err, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccRDSInstance_pendingModifiedValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_pendingModifiedValues(rName, "db.t3.micro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.%", "0"),
+				),
+			},
+			{
+				// The modification is deferred to the maintenance window. The plan after apply must be empty.
+				Config: testAccInstanceConfig_pendingModifiedValues(rName, "db.t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckDBInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.instance_class", "db.t3.small"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_manage_password(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -6289,6 +6429,27 @@ resource "aws_db_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_pendingModifiedValues(rName, instanceClass string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier              = %[1]q
+  allocated_storage       = 10
+  backup_retention_period = 0
+  engine                  = data.aws_rds_orderable_db_instance.test.engine
+  engine_version          = data.aws_rds_orderable_db_instance.test.engine_version
+  instance_class          = %[2]q
+  db_name                 = "test"
+  parameter_group_name    = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot     = true
+  password                = "avoid-plaintext-passwords"
+  username                = "tfacctest"
+  apply_immediately       = false
+}
+`, rName, instanceClass))
+}
+
 func testAccInstanceConfig_basicApplyImmediately(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -156,6 +156,11 @@ func ResourceBucket() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"force_destroy_lifecycle_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -802,6 +807,8 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("bucket", d.Id())
 	d.Set("bucket_domain_name", meta.(*conns.AWSClient).PartitionHostname(d.Id()+".s3"))
 	d.Set("bucket_prefix", create.NamePrefixFromName(d.Id()))
+	// Imported buckets get the default value.
+	d.Set("force_destroy_lifecycle_expiration", d.Get("force_destroy_lifecycle_expiration").(bool))
 
 	//
 	// Bucket Policy.
@@ -1599,7 +1606,18 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 				objectLockEnabled = v.ObjectLockEnabled == types.ObjectLockEnabledEnabled
 			}
 
-			if n, err := emptyBucket(ctx, conn, d.Id(), objectLockEnabled); err != nil {
+			var optFns []func(*emptyBucketOptions)
+			if d.Get("force_destroy_lifecycle_expiration").(bool) {
+				timeout := d.Timeout(schema.TimeoutDelete)
+
+				if timeout < emptyBucketLifecycleExpirationMinTimeout {
+					return sdkdiag.AppendErrorf(diags, "emptying S3 Bucket (%s): force_destroy_lifecycle_expiration requires a delete timeout of at least %s, got %s", d.Id(), emptyBucketLifecycleExpirationMinTimeout, timeout)
+				}
+
+				optFns = append(optFns, withEmptyBucketLifecycleExpiration(emptyBucketLifecyclePollInterval, timeout))
+			}
+
+			if n, err := emptyBucket(ctx, conn, d.Id(), objectLockEnabled, optFns...); err != nil {
 				return diag.Errorf("emptying S3 Bucket (%s): %s", d.Id(), err)
 			} else {
 				log.Printf("[DEBUG] Deleted %d S3 objects", n)
@@ -8,176 +8,335 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	// emptyBucketDefaultConcurrency is the default maximum number of concurrent DeleteObjects calls made when emptying a bucket.
+	emptyBucketDefaultConcurrency = 16
+	// emptyBucketProgressInterval is the number of deleted objects between progress log messages.
+	emptyBucketProgressInterval = 10000
+	// emptyBucketMaxBackoff is the maximum delay before retrying a DeleteObjects call that was throttled.
+	emptyBucketMaxBackoff = 10 * time.Second
+
+	// Lifecycle configuration rule ID used when expiring objects before emptying a bucket.
+	emptyBucketLifecycleRuleID = "terraform-provider-aws-empty-bucket"
+	// emptyBucketLifecyclePollInterval is the interval between checks that lifecycle expiration has removed all objects.
+	emptyBucketLifecyclePollInterval = 1 * time.Minute
+	// emptyBucketLifecycleExpirationMinTimeout is the minimum time for lifecycle expiration, which expires objects after 1 day.
+	emptyBucketLifecycleExpirationMinTimeout = 24 * time.Hour
+)
+
+type emptyBucketOptions struct {
+	concurrency                  int
+	expireWithLifecycle          bool
+	lifecycleExpirationPollDelay time.Duration
+	lifecycleExpirationTimeout   time.Duration
+}
+
+// withEmptyBucketConcurrency sets the maximum number of concurrent DeleteObjects calls.
+func withEmptyBucketConcurrency(n int) func(*emptyBucketOptions) {
+	return func(o *emptyBucketOptions) {
+		o.concurrency = n
+	}
+}
+
+// withEmptyBucketLifecycleExpiration makes emptyBucket first apply a lifecycle configuration that expires
+// all objects and wait for S3 to remove all object versions before deleting anything that remains.
+func withEmptyBucketLifecycleExpiration(pollDelay, timeout time.Duration) func(*emptyBucketOptions) {
+	return func(o *emptyBucketOptions) {
+		o.expireWithLifecycle = true
+		o.lifecycleExpirationPollDelay = pollDelay
+		o.lifecycleExpirationTimeout = timeout
+	}
+}
+
 // emptyBucket empties the specified S3 general purpose bucket by deleting all object versions and delete markers.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
+// Pages of object versions are deleted concurrently. Concurrency is reduced whenever S3 returns `SlowDown`.
+// Deletion is naturally resumable: each call lists only those object versions that remain.
+// If lifecycle expiration is enabled and the bucket can't be emptied, the bucket's original lifecycle configuration is restored.
 // Returns the number of object versions and delete markers deleted.
-func emptyBucket(ctx context.Context, conn *s3.Client, bucket string, force bool) (int64, error) {
-	nObjects, err := forEachObjectVersionsPage(ctx, conn, bucket, func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
-		return deletePageOfObjectVersions(ctx, conn, bucket, force, page)
+func emptyBucket(ctx context.Context, conn *s3.Client, bucket string, force bool, optFns ...func(*emptyBucketOptions)) (int64, error) {
+	opts := emptyBucketOptions{
+		concurrency: emptyBucketDefaultConcurrency,
+	}
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	if !opts.expireWithLifecycle {
+		return deleteBucketObjectVersions(ctx, conn, bucket, force, opts.concurrency)
+	}
+
+	rules, err := findLifecycleRules(ctx, conn, bucket, "")
+
+	if err != nil && !tfresource.NotFound(err) {
+		return 0, fmt.Errorf("reading S3 bucket (%s) lifecycle configuration: %w", bucket, err)
+	}
+
+	// Don't treat the rules from an earlier attempt to empty the bucket as the original lifecycle configuration.
+	rules = tfslices.Filter(rules, func(v types.LifecycleRule) bool {
+		return !strings.HasPrefix(aws.ToString(v.ID), emptyBucketLifecycleRuleID)
 	})
 
+	var n int64
+	err = expireBucketObjects(ctx, conn, bucket, opts.lifecycleExpirationPollDelay, opts.lifecycleExpirationTimeout)
+
+	if err == nil {
+		n, err = deleteBucketObjectVersions(ctx, conn, bucket, force, opts.concurrency)
+	}
+
 	if err != nil {
-		return nObjects, err
+		// The bucket wasn't emptied, so put back its original lifecycle configuration.
+		if restoreErr := putBucketLifecycleRules(ctx, conn, bucket, rules); restoreErr != nil {
+			err = errors.Join(err, restoreErr)
+		}
+
+		return n, err
 	}
 
-	n, err := forEachObjectVersionsPage(ctx, conn, bucket, deletePageOfDeleteMarkers)
-	nObjects += n
+	return n, nil
+}
+
+// deleteBucketObjectVersions concurrently deletes all object versions and delete markers from the specified S3 general purpose bucket.
+func deleteBucketObjectVersions(ctx context.Context, conn *s3.Client, bucket string, force bool, concurrency int) (int64, error) {
+	return deleteObjectsConcurrently(ctx, conn, bucket, force, concurrency, func(ctx context.Context, batches chan<- []types.ObjectIdentifier) error {
+		input := &s3.ListObjectVersionsInput{
+			Bucket: aws.String(bucket),
+		}
+
+		pages := s3.NewListObjectVersionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("listing S3 bucket (%s) object versions: %w", bucket, err)
+			}
+
+			batch := tfslices.ApplyToAll(page.Versions, func(v types.ObjectVersion) types.ObjectIdentifier {
+				return types.ObjectIdentifier{
+					Key:       v.Key,
+					VersionId: v.VersionId,
+				}
+			})
+			batch = append(batch, tfslices.ApplyToAll(page.DeleteMarkers, func(v types.DeleteMarkerEntry) types.ObjectIdentifier {
+				return types.ObjectIdentifier{
+					Key:       v.Key,
+					VersionId: v.VersionId,
+				}
+			})...)
 
-	return nObjects, err
+			if err := sendObjectIdentifiers(ctx, batches, batch); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
 // emptyDirectoryBucket empties the specified S3 directory bucket by deleting all objects.
 // Returns the number of objects deleted.
 func emptyDirectoryBucket(ctx context.Context, conn *s3.Client, bucket string) (int64, error) {
-	return forEachObjectsPage(ctx, conn, bucket, deletePageOfObjects)
-}
+	return deleteObjectsConcurrently(ctx, conn, bucket, false, emptyBucketDefaultConcurrency, func(ctx context.Context, batches chan<- []types.ObjectIdentifier) error {
+		input := &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+		}
 
-// forEachObjectVersionsPage calls the specified function for each page returned from the S3 ListObjectVersionsPages API.
-func forEachObjectVersionsPage(ctx context.Context, conn *s3.Client, bucket string, fn func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error)) (int64, error) {
-	var nObjects int64
+		pages := s3.NewListObjectsV2Paginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
 
-	input := &s3.ListObjectVersionsInput{
-		Bucket: aws.String(bucket),
-	}
-	var lastErr error
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+				return nil
+			}
 
-	pages := s3.NewListObjectVersionsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+			if err != nil {
+				return fmt.Errorf("listing S3 bucket (%s) objects: %w", bucket, err)
+			}
 
-		if err != nil {
-			return nObjects, fmt.Errorf("listing S3 bucket (%s) object versions: %w", bucket, err)
+			batch := tfslices.ApplyToAll(page.Contents, func(v types.Object) types.ObjectIdentifier {
+				return types.ObjectIdentifier{
+					Key: v.Key,
+				}
+			})
+
+			if err := sendObjectIdentifiers(ctx, batches, batch); err != nil {
+				return err
+			}
 		}
 
-		n, err := fn(ctx, conn, bucket, page)
-		nObjects += n
+		return nil
+	})
+}
 
-		if err != nil {
-			lastErr = err
-			break
-		}
+func sendObjectIdentifiers(ctx context.Context, batches chan<- []types.ObjectIdentifier, batch []types.ObjectIdentifier) error {
+	if len(batch) == 0 {
+		return nil
 	}
 
-	if lastErr != nil {
-		return nObjects, lastErr
+	select {
+	case batches <- batch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-
-	return nObjects, nil
 }
 
-// forEachObjectsPage calls the specified function for each page returned from the S3 ListObjectsV2 API.
-func forEachObjectsPage(ctx context.Context, conn *s3.Client, bucket string, fn func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectsV2Output) (int64, error)) (int64, error) {
-	var nObjects int64
-
-	input := &s3.ListObjectsV2Input{
-		Bucket: aws.String(bucket),
+// deleteObjectsConcurrently deletes the objects sent by `list` using up to `concurrency` concurrent DeleteObjects calls.
+// Listing stops at the first deletion error.
+// Returns the number of objects deleted.
+func deleteObjectsConcurrently(ctx context.Context, conn *s3.Client, bucket string, force bool, concurrency int, list func(context.Context, chan<- []types.ObjectIdentifier) error) (int64, error) {
+	if concurrency < 1 {
+		concurrency = 1
 	}
-	var lastErr error
 
-	pages := s3.NewListObjectsV2Paginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-		if err != nil {
-			return nObjects, fmt.Errorf("listing S3 bucket (%s) objects: %w", bucket, err)
-		}
+	batches := make(chan []types.ObjectIdentifier, concurrency)
+	throttle := newAdaptiveThrottle(concurrency)
+	progress := &deleteProgress{bucket: bucket}
 
-		n, err := fn(ctx, conn, bucket, page)
-		nObjects += n
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
 
-		if err != nil {
-			lastErr = err
-			break
-		}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for batch := range batches {
+				// Drain any remaining batches once an error has occurred.
+				if ctx.Err() != nil {
+					continue
+				}
+
+				n, err := deleteObjectsWithThrottle(ctx, conn, bucket, force, throttle, batch)
+				progress.add(n)
+
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					cancel()
+				}
+			}
+		}()
 	}
 
-	if lastErr != nil {
-		return nObjects, lastErr
+	listErr := list(ctx, batches)
+	close(batches)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return progress.total(), err
 	}
 
-	return nObjects, nil
+	return progress.total(), listErr
 }
 
-// deletePageOfObjectVersions deletes a page (<= 1000) of S3 object versions.
+// deleteObjectsWithThrottle deletes a batch (<= 1000) of S3 objects, retrying any throttled by S3.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
 // Returns the number of objects deleted.
-func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket string, force bool, page *s3.ListObjectVersionsOutput) (int64, error) {
-	toDelete := tfslices.ApplyToAll(page.Versions, func(v types.ObjectVersion) types.ObjectIdentifier {
-		return types.ObjectIdentifier{
-			Key:       v.Key,
-			VersionId: v.VersionId,
-		}
-	})
-
+func deleteObjectsWithThrottle(ctx context.Context, conn *s3.Client, bucket string, force bool, throttle *adaptiveThrottle, objects []types.ObjectIdentifier) (int64, error) {
 	var nObjects int64
-	if nObjects = int64(len(toDelete)); nObjects == 0 {
-		return nObjects, nil
-	}
 
-	input := &s3.DeleteObjectsInput{
-		Bucket: aws.String(bucket),
-		Delete: &types.Delete{
-			Objects: toDelete,
-			Quiet:   aws.Bool(true), // Only report errors.
-		},
-	}
-	if force {
-		input.BypassGovernanceRetention = aws.Bool(force)
-	}
+	for attempt := 0; len(objects) > 0; attempt++ {
+		if attempt > 0 {
+			if err := sleepContext(ctx, throttleBackoff(attempt)); err != nil {
+				return nObjects, err
+			}
+		}
 
-	output, err := conn.DeleteObjects(ctx, input)
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		}
+		if force {
+			input.BypassGovernanceRetention = aws.Bool(force)
+		}
 
-	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
-		return nObjects, nil
-	}
+		if err := throttle.acquire(ctx); err != nil {
+			return nObjects, err
+		}
+		output, err := conn.DeleteObjects(ctx, input)
+		throttle.release()
 
-	if err != nil {
-		return nObjects, fmt.Errorf("deleting S3 bucket (%s) object versions: %w", bucket, err)
-	}
+		if tfawserr.ErrCodeEquals(err, errCodeSlowDown) {
+			throttle.slowDown()
+			continue
+		}
 
-	nObjects -= int64(len(output.Errors))
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nObjects + int64(len(objects)), nil
+		}
 
-	var errs []error
-	for _, v := range output.Errors {
-		code := aws.ToString(v.Code)
-		if code == errCodeNoSuchKey {
-			continue
+		if err != nil {
+			return nObjects, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
 		}
 
-		// Attempt to remove any legal hold on the object.
-		if force && code == errCodeAccessDenied {
+		var retry []types.ObjectIdentifier
+		var errs []error
+		nObjects += int64(len(objects) - len(output.Errors))
+
+		for _, v := range output.Errors {
 			key := aws.ToString(v.Key)
 			versionID := aws.ToString(v.VersionId)
 
-			_, err := conn.PutObjectLegalHold(ctx, &s3.PutObjectLegalHoldInput{
-				Bucket:    aws.String(bucket),
-				Key:       aws.String(key),
-				VersionId: aws.String(versionID),
-				LegalHold: &types.ObjectLockLegalHold{
-					Status: types.ObjectLockLegalHoldStatusOff,
-				},
-			})
-
-			if err != nil {
-				// Add the original error and the new error.
-				errs = append(errs, newDeleteObjectVersionError(v))
-				errs = append(errs, fmt.Errorf("removing legal hold: %w", newObjectVersionError(key, versionID, err)))
-			} else {
-				// Attempt to delete the object once the legal hold has been removed.
-				_, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+			switch code := aws.ToString(v.Code); {
+			case code == errCodeNoSuchKey:
+				continue
+			case code == errCodeSlowDown:
+				retry = append(retry, types.ObjectIdentifier{
+					Key:       v.Key,
+					VersionId: v.VersionId,
+				})
+			case force && code == errCodeAccessDenied:
+				// Attempt to remove any legal hold on the object.
+				_, err := conn.PutObjectLegalHold(ctx, &s3.PutObjectLegalHoldInput{
 					Bucket:    aws.String(bucket),
 					Key:       aws.String(key),
 					VersionId: aws.String(versionID),
+					LegalHold: &types.ObjectLockLegalHold{
+						Status: types.ObjectLockLegalHoldStatusOff,
+					},
+				})
+
+				if err != nil {
+					// Add the original error and the new error.
+					errs = append(errs, newDeleteObjectVersionError(v))
+					errs = append(errs, fmt.Errorf("removing legal hold: %w", newObjectVersionError(key, versionID, err)))
+					continue
+				}
+
+				// Attempt to delete the object once the legal hold has been removed.
+				_, err = conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+					Bucket:                    aws.String(bucket),
+					Key:                       aws.String(key),
+					VersionId:                 aws.String(versionID),
+					BypassGovernanceRetention: aws.Bool(force),
 				})
 
 				if err != nil {
@@ -185,110 +344,248 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket str
 				} else {
 					nObjects++
 				}
+			default:
+				errs = append(errs, newDeleteObjectVersionError(v))
 			}
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return nObjects, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+		}
+
+		if len(retry) > 0 {
+			throttle.slowDown()
 		} else {
-			errs = append(errs, newDeleteObjectVersionError(v))
+			throttle.succeeded()
 		}
-	}
 
-	if err := errors.Join(errs...); err != nil {
-		return nObjects, fmt.Errorf("deleting S3 bucket (%s) object versions: %w", bucket, err)
+		objects = retry
 	}
 
 	return nObjects, nil
 }
 
-// deletePageOfDeleteMarkers deletes a page (<= 1000) of S3 object delete markers.
-// Returns the number of delete markers deleted.
-func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
-	toDelete := tfslices.ApplyToAll(page.DeleteMarkers, func(v types.DeleteMarkerEntry) types.ObjectIdentifier {
-		return types.ObjectIdentifier{
-			Key:       v.Key,
-			VersionId: v.VersionId,
+// expireBucketObjects applies a lifecycle configuration to the specified S3 general purpose bucket that expires all objects,
+// then waits for S3 to remove all object versions and delete markers.
+// Any existing lifecycle configuration is replaced.
+func expireBucketObjects(ctx context.Context, conn *s3.Client, bucket string, pollDelay, timeout time.Duration) error {
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &types.BucketLifecycleConfiguration{
+			Rules: []types.LifecycleRule{
+				{
+					AbortIncompleteMultipartUpload: &types.AbortIncompleteMultipartUpload{
+						DaysAfterInitiation: aws.Int32(1),
+					},
+					Expiration: &types.LifecycleExpiration{
+						Days: aws.Int32(1),
+					},
+					Filter: &types.LifecycleRuleFilterMemberPrefix{},
+					ID:     aws.String(emptyBucketLifecycleRuleID),
+					NoncurrentVersionExpiration: &types.NoncurrentVersionExpiration{
+						NoncurrentDays: aws.Int32(1),
+					},
+					Status: types.ExpirationStatusEnabled,
+				},
+				{
+					// Expired object delete markers can't be specified in the same rule as an expiration in days.
+					Expiration: &types.LifecycleExpiration{
+						ExpiredObjectDeleteMarker: aws.Bool(true),
+					},
+					Filter: &types.LifecycleRuleFilterMemberPrefix{},
+					ID:     aws.String(emptyBucketLifecycleRuleID + "-delete-markers"),
+					Status: types.ExpirationStatusEnabled,
+				},
+			},
+		},
+	}
+
+	if _, err := conn.PutBucketLifecycleConfiguration(ctx, input); err != nil {
+		return fmt.Errorf("putting S3 bucket (%s) lifecycle configuration: %w", bucket, err)
+	}
+
+	log.Printf("[INFO] Waiting for S3 bucket (%s) lifecycle configuration to expire all objects", bucket)
+	err := tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := conn.ListObjectVersions(ctx, &s3.ListObjectVersionsInput{
+			Bucket:  aws.String(bucket),
+			MaxKeys: aws.Int32(1),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return true, nil
 		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return len(output.Versions) == 0 && len(output.DeleteMarkers) == 0 && !aws.ToBool(output.IsTruncated), nil
+	}, tfresource.WaitOpts{
+		PollInterval: pollDelay,
 	})
 
-	var nObjects int64
-	if nObjects = int64(len(toDelete)); nObjects == 0 {
-		return nObjects, nil
+	if err != nil {
+		return fmt.Errorf("waiting for S3 bucket (%s) objects to expire: %w", bucket, err)
 	}
 
-	input := &s3.DeleteObjectsInput{
-		Bucket: aws.String(bucket),
-		Delete: &types.Delete{
-			Objects: toDelete,
-			Quiet:   aws.Bool(true), // Only report errors.
-		},
+	return nil
+}
+
+// putBucketLifecycleRules sets the specified S3 general purpose bucket's lifecycle configuration rules.
+// If there are no rules then any lifecycle configuration is deleted.
+func putBucketLifecycleRules(ctx context.Context, conn *s3.Client, bucket string, rules []types.LifecycleRule) error {
+	if len(rules) == 0 {
+		_, err := conn.DeleteBucketLifecycle(ctx, &s3.DeleteBucketLifecycleInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("restoring S3 bucket (%s) lifecycle configuration: %w", bucket, err)
+		}
+
+		return nil
 	}
 
-	output, err := conn.DeleteObjects(ctx, input)
+	_, err := conn.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &types.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	})
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
-		return nObjects, nil
+		return nil
 	}
 
 	if err != nil {
-		return nObjects, fmt.Errorf("deleting S3 bucket (%s) delete markers: %w", bucket, err)
+		return fmt.Errorf("restoring S3 bucket (%s) lifecycle configuration: %w", bucket, err)
 	}
 
-	nObjects -= int64(len(output.Errors))
+	return nil
+}
 
-	var errs []error
-	for _, v := range output.Errors {
-		errs = append(errs, newDeleteObjectVersionError(v))
-	}
+// adaptiveThrottle limits the number of concurrent requests.
+// The limit is halved whenever S3 asks for requests to slow down and is increased by one after
+// as many consecutive successful requests as the current limit.
+type adaptiveThrottle struct {
+	mu        sync.Mutex
+	cond      *sync.Cond
+	inFlight  int
+	limit     int
+	max       int
+	successes int
+}
 
-	if err := errors.Join(errs...); err != nil {
-		return nObjects, fmt.Errorf("deleting S3 bucket (%s) delete markers: %w", bucket, err)
+func newAdaptiveThrottle(max int) *adaptiveThrottle {
+	t := &adaptiveThrottle{
+		limit: max,
+		max:   max,
 	}
+	t.cond = sync.NewCond(&t.mu)
 
-	return nObjects, nil
+	return t
 }
 
-// deletePageOfObjects deletes a page (<= 1000) of S3 objects.
-// Returns the number of objects deleted.
-func deletePageOfObjects(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectsV2Output) (int64, error) {
-	toDelete := tfslices.ApplyToAll(page.Contents, func(v types.Object) types.ObjectIdentifier {
-		return types.ObjectIdentifier{
-			Key: v.Key,
+func (t *adaptiveThrottle) acquire(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for t.inFlight >= t.limit {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
-	})
+		t.cond.Wait()
+	}
+	t.inFlight++
 
-	var nObjects int64
-	if nObjects = int64(len(toDelete)); nObjects == 0 {
-		return nObjects, nil
+	return nil
+}
+
+func (t *adaptiveThrottle) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.inFlight--
+	t.cond.Broadcast()
+}
+
+func (t *adaptiveThrottle) slowDown() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if limit := t.limit / 2; limit >= 1 {
+		t.limit = limit
+	} else {
+		t.limit = 1
 	}
+	t.successes = 0
+}
 
-	input := &s3.DeleteObjectsInput{
-		Bucket: aws.String(bucket),
-		Delete: &types.Delete{
-			Objects: toDelete,
-			Quiet:   aws.Bool(true), // Only report errors.
-		},
+func (t *adaptiveThrottle) succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.limit >= t.max {
+		return
 	}
 
-	output, err := conn.DeleteObjects(ctx, input)
+	if t.successes++; t.successes >= t.limit {
+		t.limit++
+		t.successes = 0
+		t.cond.Broadcast()
+	}
+}
 
-	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
-		return nObjects, nil
+// throttleBackoff returns the delay before retry attempt `attempt` (>= 1) of a throttled request.
+func throttleBackoff(attempt int) time.Duration {
+	d := 100 * time.Millisecond
+	for i := 1; i < attempt && d < emptyBucketMaxBackoff; i++ {
+		d *= 2
 	}
 
-	if err != nil {
-		return nObjects, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+	if d > emptyBucketMaxBackoff {
+		d = emptyBucketMaxBackoff
 	}
 
-	nObjects -= int64(len(output.Errors))
+	return d
+}
 
-	var errs []error
-	for _, v := range output.Errors {
-		errs = append(errs, newDeleteObjectVersionError(v))
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
+}
 
-	if err := errors.Join(errs...); err != nil {
-		return nObjects, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+// deleteProgress counts deleted objects, periodically logging progress.
+type deleteProgress struct {
+	bucket   string
+	nObjects atomic.Int64
+}
+
+func (p *deleteProgress) add(n int64) {
+	if n == 0 {
+		return
 	}
 
-	return nObjects, nil
+	total := p.nObjects.Add(n)
+
+	if (total-n)/emptyBucketProgressInterval != total/emptyBucketProgressInterval {
+		log.Printf("[INFO] Emptying S3 bucket (%s): %d objects deleted", p.bucket, total)
+	}
+}
+
+func (p *deleteProgress) total() int64 {
+	return p.nObjects.Load()
 }
 
 func newObjectVersionError(key, versionID string, err error) error {
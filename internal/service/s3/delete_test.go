@@ -4,9 +4,20 @@
 package s3_test
 
 import (
+	"encoding/xml"
 	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -65,3 +76,447 @@ func TestDeleteAllObjectVersions(t *testing.T) {
 
 	t.Logf("%d S3 objects deleted", n)
 }
+
+func TestEmptyBucket_fakeEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	fake := newFakeS3(t, "test-bucket", 1200, 2, 300)
+	client := fake.client()
+
+	n, err := tfs3.EmptyBucket(ctx, client, "test-bucket", false, tfs3.WithEmptyBucketConcurrency(4))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := n, int64(2700); got != want {
+		t.Errorf("objects deleted = %d, want %d", got, want)
+	}
+	if got := fake.remaining(); got != 0 {
+		t.Errorf("objects remaining = %d, want 0", got)
+	}
+	if got := fake.maxInFlight; got < 2 {
+		t.Errorf("maximum concurrent DeleteObjects calls = %d, want at least 2", got)
+	}
+	if got := fake.maxInFlight; got > 4 {
+		t.Errorf("maximum concurrent DeleteObjects calls = %d, want at most 4", got)
+	}
+}
+
+func TestEmptyBucket_fakeEndpointSlowDown(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	fake := newFakeS3(t, "test-bucket", 1200, 2, 300)
+	fake.slowDowns = 5
+	fake.objectSlowDowns = 10
+	client := fake.client()
+
+	n, err := tfs3.EmptyBucket(ctx, client, "test-bucket", false, tfs3.WithEmptyBucketConcurrency(4))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := n, int64(2700); got != want {
+		t.Errorf("objects deleted = %d, want %d", got, want)
+	}
+	if got := fake.remaining(); got != 0 {
+		t.Errorf("objects remaining = %d, want 0", got)
+	}
+	if got := fake.slowDowns; got != 0 {
+		t.Errorf("unconsumed SlowDown responses = %d, want 0", got)
+	}
+}
+
+func TestEmptyBucket_fakeEndpointLifecycleExpiration(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	fake := newFakeS3(t, "test-bucket", 100, 3, 50)
+	client := fake.client()
+
+	n, err := tfs3.EmptyBucket(ctx, client, "test-bucket", false, tfs3.WithEmptyBucketLifecycleExpiration(10*time.Millisecond, time.Minute))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !fake.lifecycleConfigured {
+		t.Error("lifecycle configuration not applied")
+	}
+	// All object versions and delete markers are expired by the lifecycle configuration.
+	if got, want := n, int64(0); got != want {
+		t.Errorf("objects deleted = %d, want %d", got, want)
+	}
+	if got := fake.remaining(); got != 0 {
+		t.Errorf("objects remaining = %d, want 0", got)
+	}
+}
+
+func TestEmptyBucket_fakeEndpointLifecycleExpirationTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	fake := newFakeS3(t, "test-bucket", 100, 3, 50)
+	fake.lifecycle = `<LifecycleConfiguration><Rule><ID>original</ID><Filter><Prefix>logs/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>30</Days></Expiration></Rule></LifecycleConfiguration>`
+	fake.lifecycleStalled = true
+	client := fake.client()
+
+	_, err := tfs3.EmptyBucket(ctx, client, "test-bucket", false, tfs3.WithEmptyBucketLifecycleExpiration(10*time.Millisecond, 100*time.Millisecond))
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !fake.lifecycleConfigured {
+		t.Error("lifecycle configuration not applied")
+	}
+	if got := fake.remaining(); got != 350 {
+		t.Errorf("objects remaining = %d, want 350", got)
+	}
+	// The original lifecycle configuration is restored.
+	if got := fake.lifecycle; !strings.Contains(got, "<ID>original</ID>") || strings.Contains(got, "terraform-provider-aws-empty-bucket") {
+		t.Errorf("lifecycle configuration = %s, want original", got)
+	}
+}
+
+func TestEmptyDirectoryBucket_fakeEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	fake := newFakeS3(t, "test-bucket", 2500, 1, 0)
+	client := fake.client()
+
+	n, err := tfs3.EmptyDirectoryBucket(ctx, client, "test-bucket")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := n, int64(2500); got != want {
+		t.Errorf("objects deleted = %d, want %d", got, want)
+	}
+	if got := fake.remaining(); got != 0 {
+		t.Errorf("objects remaining = %d, want 0", got)
+	}
+}
+
+type fakeS3ObjectVersion struct {
+	key          string
+	versionID    string
+	deleteMarker bool
+}
+
+// fakeS3 is a minimal in-memory S3 endpoint serving the APIs used to empty a bucket.
+type fakeS3 struct {
+	t      *testing.T
+	server *httptest.Server
+	bucket string
+
+	mu                  sync.Mutex
+	versions            []fakeS3ObjectVersion // Sorted by key and version ID.
+	index               map[string]int
+	deleted             map[int]bool
+	slowDowns           int // Number of DeleteObjects calls to reject with SlowDown.
+	objectSlowDowns     int // Number of objects to report as SlowDown errors.
+	inFlight            int
+	maxInFlight         int
+	overlap             chan struct{} // Closed once DeleteObjects calls overlap.
+	overlapOnce         sync.Once
+	lifecycleConfigured bool
+	lifecycle           string // Current lifecycle configuration document.
+	lifecycleStalled    bool   // Whether lifecycle expiration never removes any objects.
+}
+
+func newFakeS3(t *testing.T, bucket string, nKeys, nVersions, nDeleteMarkers int) *fakeS3 {
+	t.Helper()
+
+	fake := &fakeS3{
+		t:       t,
+		bucket:  bucket,
+		index:   make(map[string]int),
+		deleted: make(map[int]bool),
+		overlap: make(chan struct{}),
+	}
+
+	for i := 0; i < nKeys; i++ {
+		key := fmt.Sprintf("key-%06d", i)
+
+		for j := 0; j < nVersions; j++ {
+			versionID := ""
+			if nVersions > 1 {
+				versionID = fmt.Sprintf("v%d", j)
+			}
+
+			fake.versions = append(fake.versions, fakeS3ObjectVersion{key: key, versionID: versionID})
+		}
+
+		if i < nDeleteMarkers {
+			fake.versions = append(fake.versions, fakeS3ObjectVersion{key: key, versionID: "z", deleteMarker: true})
+		}
+	}
+
+	for i, v := range fake.versions {
+		fake.index[v.key+"\x00"+v.versionID] = i
+	}
+
+	fake.server = httptest.NewServer(fake)
+	t.Cleanup(fake.server.Close)
+
+	return fake
+}
+
+func (fake *fakeS3) client() *s3.Client {
+	return s3.New(s3.Options{
+		BaseEndpoint:     aws.String(fake.server.URL),
+		Credentials:      aws.AnonymousCredentials{},
+		Region:           "us-west-2", //lintignore:AWSAT003
+		RetryMaxAttempts: 1,
+		UsePathStyle:     true,
+	})
+}
+
+func (fake *fakeS3) remaining() int {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	return len(fake.versions) - len(fake.deleted)
+}
+
+func (fake *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if key, ok := strings.CutPrefix(r.URL.Path, "/"+fake.bucket+"/"); ok && r.Method == http.MethodHead {
+		fake.headObject(w, key)
+		return
+	}
+
+	if r.URL.Path != "/"+fake.bucket {
+		fake.writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	query := r.URL.Query()
+
+	switch {
+	case r.Method == http.MethodGet && query.Has("versions"):
+		fake.listObjectVersions(w, query)
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		fake.listObjectsV2(w, query)
+	case r.Method == http.MethodPost && query.Has("delete"):
+		fake.deleteObjects(w, r)
+	case r.Method == http.MethodGet && query.Has("lifecycle"):
+		fake.getBucketLifecycleConfiguration(w)
+	case r.Method == http.MethodPut && query.Has("lifecycle"):
+		fake.putBucketLifecycleConfiguration(w, r)
+	case r.Method == http.MethodDelete && query.Has("lifecycle"):
+		fake.deleteBucketLifecycle(w)
+	default:
+		fake.t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		fake.writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (fake *fakeS3) listObjectVersions(w http.ResponseWriter, query url.Values) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	start := 0
+	if v := query.Get("key-marker"); v != "" {
+		start = fake.index[v+"\x00"+query.Get("version-id-marker")] + 1
+	}
+	maxKeys := 1000
+	if v, err := strconv.Atoi(query.Get("max-keys")); err == nil {
+		maxKeys = v
+	}
+
+	var b strings.Builder
+	var n int
+	var last fakeS3ObjectVersion
+	truncated := false
+	for i := start; i < len(fake.versions); i++ {
+		if fake.deleted[i] {
+			continue
+		}
+		if n == maxKeys {
+			truncated = true
+			break
+		}
+
+		v := fake.versions[i]
+		if v.deleteMarker {
+			fmt.Fprintf(&b, "<DeleteMarker><Key>%s</Key><VersionId>%s</VersionId></DeleteMarker>", v.key, v.versionID)
+		} else {
+			fmt.Fprintf(&b, "<Version><Key>%s</Key><VersionId>%s</VersionId></Version>", v.key, v.versionID)
+		}
+		last = v
+		n++
+	}
+
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><ListVersionsResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>%s</Name><IsTruncated>%t</IsTruncated>`, fake.bucket, truncated)
+	if truncated {
+		fmt.Fprintf(w, "<NextKeyMarker>%s</NextKeyMarker><NextVersionIdMarker>%s</NextVersionIdMarker>", last.key, last.versionID)
+	}
+	fmt.Fprint(w, b.String(), "</ListVersionsResult>")
+}
+
+func (fake *fakeS3) listObjectsV2(w http.ResponseWriter, query url.Values) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	start, _ := strconv.Atoi(query.Get("continuation-token"))
+
+	var b strings.Builder
+	var n int
+	next := -1
+	for i := start; i < len(fake.versions); i++ {
+		if fake.deleted[i] || fake.versions[i].deleteMarker {
+			continue
+		}
+		if n == 1000 {
+			next = i
+			break
+		}
+
+		fmt.Fprintf(&b, "<Contents><Key>%s</Key></Contents>", fake.versions[i].key)
+		n++
+	}
+
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>%s</Name><KeyCount>%d</KeyCount><IsTruncated>%t</IsTruncated>`, fake.bucket, n, next >= 0)
+	if next >= 0 {
+		fmt.Fprintf(w, "<NextContinuationToken>%d</NextContinuationToken>", next)
+	}
+	fmt.Fprint(w, b.String(), "</ListBucketResult>")
+}
+
+func (fake *fakeS3) deleteObjects(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Objects []struct {
+			Key       string `xml:"Key"`
+			VersionID string `xml:"VersionId"`
+		} `xml:"Object"`
+	}
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		fake.t.Errorf("decoding DeleteObjects request: %s", err)
+		fake.writeError(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+
+	fake.mu.Lock()
+	fake.inFlight++
+	if fake.inFlight > fake.maxInFlight {
+		fake.maxInFlight = fake.inFlight
+	}
+	if fake.inFlight > 1 {
+		fake.overlapOnce.Do(func() { close(fake.overlap) })
+	}
+	slowDown := fake.slowDowns > 0
+	if slowDown {
+		fake.slowDowns--
+	}
+	fake.mu.Unlock()
+
+	// Hold requests until concurrent requests are seen to overlap.
+	select {
+	case <-fake.overlap:
+	case <-time.After(time.Second):
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	fake.inFlight--
+
+	if slowDown {
+		fake.writeError(w, http.StatusServiceUnavailable, "SlowDown")
+		return
+	}
+
+	var b strings.Builder
+	for _, v := range request.Objects {
+		if fake.objectSlowDowns > 0 {
+			fake.objectSlowDowns--
+			fmt.Fprintf(&b, "<Error><Key>%s</Key><VersionId>%s</VersionId><Code>SlowDown</Code><Message>Please reduce your request rate.</Message></Error>", v.Key, v.VersionID)
+			continue
+		}
+
+		if i, ok := fake.index[v.Key+"\x00"+v.VersionID]; ok {
+			fake.deleted[i] = true
+		}
+	}
+
+	fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><DeleteResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`, b.String(), "</DeleteResult>")
+}
+
+func (fake *fakeS3) getBucketLifecycleConfiguration(w http.ResponseWriter) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	if fake.lifecycle == "" {
+		fake.writeError(w, http.StatusNotFound, "NoSuchLifecycleConfiguration")
+		return
+	}
+
+	fmt.Fprint(w, fake.lifecycle)
+}
+
+// putBucketLifecycleConfiguration simulates S3 immediately expiring all object versions and delete markers
+// when the lifecycle configuration used to empty a bucket is applied.
+func (fake *fakeS3) putBucketLifecycleConfiguration(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		fake.t.Errorf("reading PutBucketLifecycleConfiguration request: %s", err)
+		fake.writeError(w, http.StatusBadRequest, "MalformedXML")
+		return
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	fake.lifecycle = string(body)
+
+	if strings.Contains(fake.lifecycle, "terraform-provider-aws-empty-bucket") {
+		fake.lifecycleConfigured = true
+
+		if !fake.lifecycleStalled {
+			for i := range fake.versions {
+				fake.deleted[i] = true
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (fake *fakeS3) deleteBucketLifecycle(w http.ResponseWriter) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	fake.lifecycle = ""
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *fakeS3) headObject(w http.ResponseWriter, key string) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	// The latest remaining version is the current version.
+	for i := len(fake.versions) - 1; i >= 0; i-- {
+		if v := fake.versions[i]; v.key == key && !fake.deleted[i] {
+			if v.deleteMarker {
+				break
+			}
+
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (fake *fakeS3) writeError(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
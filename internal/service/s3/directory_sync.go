@@ -0,0 +1,683 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/glob"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	directorySyncDefaultConcurrency = 10
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return directorySyncCleanKeyPrefix(v.(string))
+				},
+			},
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	keyPrefix := directorySyncCleanKeyPrefix(d.Get("key_prefix").(string))
+
+	files, err := directorySyncFilesFromResource(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Set the ID before uploading so that any objects uploaded before a failure are tracked and deleted on destroy.
+	d.SetId(directorySyncCreateResourceID(bucket, keyPrefix))
+
+	if err := uploadDirectorySyncFiles(ctx, conn, bucket, files, d.Get("cache_control").(string), d.Get("concurrency").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "uploading to S3 Bucket (%s): %s", bucket, err)
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		keys, err := findObjectKeysByPrefix(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing S3 Bucket (%s) objects: %s", bucket, err)
+		}
+
+		if err := deleteObjectKeys(ctx, conn, bucket, extraneousDirectorySyncKeys(keys, files), d.Get("concurrency").(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting extraneous S3 Bucket (%s) objects: %s", bucket, err)
+		}
+	}
+
+	d.Set("manifest", directorySyncManifest(files))
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, keyPrefix, err := directorySyncParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	// Only list the whole key prefix when unmanaged objects must be found; otherwise check just the managed objects.
+	var keys map[string]bool
+	if d.Get("delete_extraneous").(bool) {
+		keys, err = findObjectKeysByPrefix(ctx, conn, bucket, keyPrefix)
+	} else if err = findBucket(ctx, conn, bucket); err == nil {
+		keys, err = findDirectorySyncObjectKeys(ctx, conn, bucket, maps.Keys(d.Get("manifest").(map[string]interface{})), d.Get("concurrency").(int))
+	}
+
+	if !d.IsNewResource() && (tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket)) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing Directory Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) objects: %s", bucket, err)
+	}
+
+	// Objects deleted outside of Terraform are removed from the manifest so that they are uploaded again.
+	// Unmanaged objects are added to the manifest (with no content hash) so that they are deleted.
+	manifest := make(map[string]interface{})
+	for k, v := range d.Get("manifest").(map[string]interface{}) {
+		if keys[k] {
+			manifest[k] = v
+		}
+	}
+	if d.Get("delete_extraneous").(bool) {
+		for k := range keys {
+			if _, ok := manifest[k]; !ok {
+				manifest[k] = ""
+			}
+		}
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key_prefix", keyPrefix)
+	d.Set("manifest", manifest)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	files, err := directorySyncFilesFromResource(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	o, _ := d.GetChange("manifest")
+	old := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	if d.Get("delete_extraneous").(bool) {
+		keys, err := findObjectKeysByPrefix(ctx, conn, bucket, directorySyncCleanKeyPrefix(d.Get("key_prefix").(string)))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing S3 Bucket (%s) objects: %s", bucket, err)
+		}
+
+		for k := range keys {
+			if _, ok := old[k]; !ok {
+				old[k] = ""
+			}
+		}
+	}
+
+	// Changes to object metadata require all objects to be uploaded again.
+	uploadAll := d.HasChange("cache_control")
+	var changed []directorySyncFile
+	for _, v := range files {
+		if uploadAll || old[v.key] != v.hash {
+			changed = append(changed, v)
+		}
+	}
+
+	if err := uploadDirectorySyncFiles(ctx, conn, bucket, changed, d.Get("cache_control").(string), d.Get("concurrency").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "uploading to S3 Bucket (%s): %s", bucket, err)
+	}
+
+	if err := deleteObjectKeys(ctx, conn, bucket, extraneousDirectorySyncKeys(old, files), d.Get("concurrency").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) objects: %s", bucket, err)
+	}
+
+	d.Set("manifest", directorySyncManifest(files))
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	keys := make(map[string]bool)
+	for k := range d.Get("manifest").(map[string]interface{}) {
+		keys[k] = true
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync: %s", d.Id())
+	if err := deleteObjectKeys(ctx, conn, bucket, keys, d.Get("concurrency").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) objects: %s", bucket, err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Deleting extraneous objects from the root of the bucket would delete every object in the bucket not in source.
+	if d.NewValueKnown("key_prefix") && d.Get("delete_extraneous").(bool) && directorySyncCleanKeyPrefix(d.Get("key_prefix").(string)) == "" {
+		return errors.New(`"key_prefix" must be set to a non-root prefix when "delete_extraneous" is true`)
+	}
+
+	for _, key := range []string{"exclude", "include", "key_prefix", "source"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("manifest")
+		}
+	}
+
+	files, err := directorySyncFilesFromResource(d)
+
+	if err != nil {
+		return err
+	}
+
+	o := d.Get("manifest").(map[string]interface{})
+	n := directorySyncManifest(files)
+
+	if len(o) == len(n) {
+		equal := true
+		for k, v := range n {
+			if o[k] != v {
+				equal = false
+				break
+			}
+		}
+
+		if equal {
+			return nil
+		}
+	}
+
+	return d.SetNew("manifest", n)
+}
+
+const directorySyncResourceIDSeparator = "/"
+
+// directorySyncCleanKeyPrefix returns the key prefix in the form "a/b/", or "" for the bucket root.
+func directorySyncCleanKeyPrefix(keyPrefix string) string {
+	keyPrefix = sdkv1CompatibleCleanKey(keyPrefix)
+
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		keyPrefix += "/"
+	}
+
+	return keyPrefix
+}
+
+func directorySyncCreateResourceID(bucket, keyPrefix string) string {
+	return bucket + directorySyncResourceIDSeparator + keyPrefix
+}
+
+func directorySyncParseResourceID(id string) (string, string, error) {
+	bucket, keyPrefix, found := strings.Cut(id, directorySyncResourceIDSeparator)
+
+	if !found || bucket == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sKEY_PREFIX", id, directorySyncResourceIDSeparator)
+	}
+
+	return bucket, keyPrefix, nil
+}
+
+// directorySyncFile is a local file to be synchronized to an S3 object.
+type directorySyncFile struct {
+	path        string
+	key         string
+	hash        string
+	contentType string
+}
+
+func directorySyncFilesFromResource(d interface{ Get(string) any }) ([]directorySyncFile, error) {
+	source, err := homedir.Expand(d.Get("source").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", d.Get("source").(string), err)
+	}
+
+	include := flex.ExpandStringValueSet(d.Get("include").(*schema.Set))
+	exclude := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+
+	return findDirectorySyncFiles(source, directorySyncCleanKeyPrefix(d.Get("key_prefix").(string)), include, exclude)
+}
+
+// findDirectorySyncFiles walks the local directory `source`, returning the files to be synchronized sorted by S3 object key.
+// A file is synchronized if its slash-separated path relative to `source` matches any of the `include` patterns
+// (or there are no `include` patterns) and does not match any of the `exclude` patterns.
+func findDirectorySyncFiles(source, keyPrefix string, include, exclude []string) ([]directorySyncFile, error) {
+	includeRes := tfslices.ApplyToAll(include, glob.Compile)
+	excludeRes := tfslices.ApplyToAll(exclude, glob.Compile)
+
+	var files []directorySyncFile
+
+	err := filepath.WalkDir(source, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		if len(includeRes) > 0 && !glob.MatchAny(includeRes, rel) {
+			return nil
+		}
+
+		if glob.MatchAny(excludeRes, rel) {
+			return nil
+		}
+
+		hash, contentType, err := directorySyncFileHashAndContentType(p)
+
+		if err != nil {
+			return err
+		}
+
+		files = append(files, directorySyncFile{
+			path:        p,
+			key:         sdkv1CompatibleCleanKey(path.Join(keyPrefix, rel)),
+			hash:        hash,
+			contentType: contentType,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].key < files[j].key
+	})
+
+	return files, nil
+}
+
+// directorySyncFileHashAndContentType returns the hex-encoded SHA-256 hash and MIME type of the specified file.
+// The MIME type is determined from the file's extension or, failing that, its content.
+func directorySyncFileHashAndContentType(path string) (string, string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	h := sha256.New()
+
+	var r io.Reader = file
+	if contentType == "" {
+		// http.DetectContentType considers at most the first 512 bytes.
+		buf := make([]byte, 512)
+		n, err := io.ReadFull(file, buf)
+
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", "", err
+		}
+
+		contentType = http.DetectContentType(buf[:n])
+		h.Write(buf[:n])
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), contentType, nil
+}
+
+func directorySyncManifest(files []directorySyncFile) map[string]interface{} {
+	manifest := make(map[string]interface{}, len(files))
+
+	for _, v := range files {
+		manifest[v.key] = v.hash
+	}
+
+	return manifest
+}
+
+// extraneousDirectorySyncKeys returns those keys that do not correspond to any of the specified files.
+func extraneousDirectorySyncKeys[V any](keys map[string]V, files []directorySyncFile) map[string]bool {
+	extraneous := make(map[string]bool)
+
+	for k := range keys {
+		extraneous[k] = true
+	}
+	for _, v := range files {
+		delete(extraneous, v.key)
+	}
+
+	return extraneous
+}
+
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.Client, bucket string, files []directorySyncFile, cacheControl string, concurrency int) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	uploader := manager.NewUploader(conn)
+	queue := make(chan directorySyncFile)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for file := range queue {
+				if err := uploadDirectorySyncFile(ctx, uploader, bucket, file, cacheControl); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+
+send:
+	for _, file := range files {
+		select {
+		case queue <- file:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, bucket string, file directorySyncFile, cacheControl string) error {
+	body, err := os.Open(file.path)
+
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	input := &s3.PutObjectInput{
+		Body:        body,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.contentType),
+		Key:         aws.String(file.key),
+	}
+
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+
+	log.Printf("[DEBUG] Uploading %s to S3 Bucket (%s) Object (%s)", file.path, bucket, file.key)
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s): %w", file.key, err)
+	}
+
+	return nil
+}
+
+// deleteObjectKeys deletes the current versions of the specified objects.
+func deleteObjectKeys(ctx context.Context, conn *s3.Client, bucket string, keys map[string]bool, concurrency int) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	objects := make([]types.ObjectIdentifier, 0, len(keys))
+	for k := range keys {
+		objects = append(objects, types.ObjectIdentifier{
+			Key: aws.String(k),
+		})
+	}
+
+	_, err := deleteObjectsConcurrently(ctx, conn, bucket, false, concurrency, func(ctx context.Context, batches chan<- []types.ObjectIdentifier) error {
+		// DeleteObjects accepts at most 1000 keys.
+		for _, batch := range tfslices.Chunks(objects, 1000) {
+			if err := sendObjectIdentifiers(ctx, batches, batch); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return err
+}
+
+// findDirectorySyncObjectKeys returns which of the specified objects exist.
+func findDirectorySyncObjectKeys(ctx context.Context, conn *s3.Client, bucket string, keys []string, concurrency int) (map[string]bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queue := make(chan string)
+	found := make(map[string]bool, len(keys))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for key := range queue {
+				_, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("reading S3 Object (%s): %w", key, err))
+					cancel()
+				} else {
+					found[key] = true
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+send:
+	for _, key := range keys {
+		select {
+		case queue <- key:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// findObjectKeysByPrefix returns the keys of all objects whose key starts with the specified prefix.
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]bool, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	keys := make(map[string]bool)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			keys[aws.ToString(v.Key)] = true
+		}
+	}
+
+	return keys, nil
+}
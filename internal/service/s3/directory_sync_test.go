@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFindDirectorySyncFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"docs/v1/index.md":  "# Docs",
+		".git/config":       "[core]",
+		".gitignore":        "drafts/",
+		"drafts/readme.txt": "draft",
+		"a+b(c).txt":        "special",
+		"données/été.txt":   "unicode",
+		"img/logo.png":      "png",
+		"img/logo.jpeg":     "jpeg",
+	})
+
+	testCases := []struct {
+		name      string
+		keyPrefix string
+		include   []string
+		exclude   []string
+		expected  []string
+	}{
+		{
+			name:     "all",
+			expected: []string{".git/config", ".gitignore", "a+b(c).txt", "css/site.css", "docs/v1/index.md", "données/été.txt", "drafts/readme.txt", "img/logo.jpeg", "img/logo.png", "index.html"},
+		},
+		{
+			name:      "key prefix",
+			keyPrefix: "site/",
+			include:   []string{"*.html", "css/**"},
+			expected:  []string{"site/css/site.css", "site/index.html"},
+		},
+		{
+			name:     "include",
+			include:  []string{"**/*.html", "**/*.css"},
+			expected: []string{"css/site.css", "index.html"},
+		},
+		{
+			name:     "exclude",
+			exclude:  []string{".git/**", "drafts/**", "données/*", "img/**", "*.txt"},
+			expected: []string{".gitignore", "css/site.css", "docs/v1/index.md", "index.html"},
+		},
+		{
+			name:     "single character wildcard",
+			include:  []string{"img/logo.???"},
+			expected: []string{"img/logo.png"},
+		},
+		{
+			name:     "top-level wildcard",
+			include:  []string{"*.html", "*.txt"},
+			expected: []string{"a+b(c).txt", "index.html"},
+		},
+		{
+			name:     "special characters",
+			include:  []string{"a+b(c).txt", "données/*"},
+			expected: []string{"a+b(c).txt", "données/été.txt"},
+		},
+		{
+			name:     "include and exclude",
+			include:  []string{"**/index.*"},
+			exclude:  []string{"docs/**"},
+			expected: []string{"index.html"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := tfs3.FindDirectorySyncFiles(dir, testCase.keyPrefix, testCase.include, testCase.exclude)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			manifest := tfs3.DirectorySyncManifest(files)
+
+			if got, expected := len(manifest), len(testCase.expected); got != expected {
+				t.Fatalf("got %d files, expected %d", got, expected)
+			}
+
+			for _, key := range testCase.expected {
+				if _, ok := manifest[key]; !ok {
+					t.Errorf("expected key %q not found in %v", key, manifest)
+				}
+			}
+		})
+	}
+
+	// SHA-256 of "body {}".
+	files, err := tfs3.FindDirectorySyncFiles(dir, "", []string{"css/*"}, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := tfs3.DirectorySyncManifest(files)["css/site.css"], "62368a1a29259b30bac235c0e75dc700c9b3bacf1513ad5708e4fe4a6c0d6560"; got != expected {
+		t.Errorf("got hash %q, expected %q", got, expected)
+	}
+}
+
+func TestFindDirectorySyncObjectKeys_fakeEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	// key-000000's current version is a delete marker.
+	fake := newFakeS3(t, "test-bucket", 5, 1, 1)
+
+	keys, err := tfs3.FindDirectorySyncObjectKeys(ctx, fake.client(), "test-bucket", []string{"key-000000", "key-000001", "key-000004", "missing"}, 2)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(keys, map[string]bool{"key-000001": true, "key-000004": true}); diff != "" {
+		t.Errorf("unexpected keys diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+		"notes":        "notes",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/notes"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(ctx, resourceName, "site/notes", "text/plain; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFile(t, source, "index.html", "<html><body></body></html>")
+					testAccDirectorySyncWriteFile(t, source, "js/site.js", "'use strict';")
+					testAccDirectorySyncRemoveFile(t, source, "notes")
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/js/site.js"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/notes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceDirectorySync(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":       "<html></html>",
+		"docs/index.html":  "<html></html>",
+		"docs/draft.html":  "<html></html>",
+		"css/site.css":     "body {}",
+		"terraform.tfvars": "secret = true",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_includeExclude(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.docs/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.index.html"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "docs/draft.html"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "terraform.tfvars"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "true"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					testAccCheckDirectorySyncPutObject(ctx, resourceName, "site/extraneous.txt"),
+					testAccCheckDirectorySyncPutObject(ctx, resourceName, "site-other/unrelated.txt"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/index.html"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/extraneous.txt"),
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "site-other/unrelated.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteExtraneousRootKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectorySyncConfig_deleteExtraneousKeyPrefix(rName, source, "/"),
+				ExpectError: regexache.MustCompile(`"key_prefix" must be set to a non-root prefix when "delete_extraneous" is true`),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				key, ok := strings.CutPrefix(k, "manifest.")
+				if !ok || key == "%" || v == "" {
+					continue
+				}
+
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Object %s still exists in Bucket %s", key, rs.Primary.Attributes["bucket"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		return err
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s exists in Bucket %s", key, rs.Primary.Attributes["bucket"])
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(ctx context.Context, n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object %s content type: got %q, expected %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDirectorySyncPutObject creates an object outside of Terraform.
+func testAccCheckDirectorySyncPutObject(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+			Body:   strings.NewReader("extraneous"),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+// testAccDirectorySyncSource creates a temporary directory containing the specified files.
+func testAccDirectorySyncSource(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for k, v := range files {
+		testAccDirectorySyncWriteFile(t, dir, k, v)
+	}
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectorySyncRemoveFile(t *testing.T, dir, name string) {
+	t.Helper()
+
+	if err := os.Remove(filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[2]q
+  key_prefix = "site"
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_includeExclude(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  source  = %[2]q
+  include = ["**/*.html", "**/*.css"]
+  exclude = ["**/draft.*"]
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_deleteExtraneous(rName, source string) string {
+	return testAccDirectorySyncConfig_deleteExtraneousKeyPrefix(rName, source, "site/")
+}
+
+func testAccDirectorySyncConfig_deleteExtraneousKeyPrefix(rName, source, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  source            = %[2]q
+  key_prefix        = %[3]q
+  delete_extraneous = true
+}
+`, rName, source, keyPrefix)
+}
@@ -39,6 +39,7 @@ const (
 	errCodeOwnershipControlsNotFoundError            = "OwnershipControlsNotFoundError"
 	errCodeReplicationConfigurationNotFound          = "ReplicationConfigurationNotFoundError"
 	errCodeServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+	errCodeSlowDown                                  = "SlowDown"
 	errCodeUnsupportedArgument                       = "UnsupportedArgument"
 	// errCodeXNotImplemented is returned from third-party S3 API implementations.
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/14645.
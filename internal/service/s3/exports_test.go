@@ -10,7 +10,9 @@ var (
 	BucketRegionalDomainName              = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
+	DirectorySyncManifest                 = directorySyncManifest
 	EmptyBucket                           = emptyBucket
+	EmptyDirectoryBucket                  = emptyDirectoryBucket
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
@@ -21,6 +23,8 @@ var (
 	FindBucketVersioning                  = findBucketVersioning
 	FindBucketWebsite                     = findBucketWebsite
 	FindCORSRules                         = findCORSRules
+	FindDirectorySyncFiles                = findDirectorySyncFiles
+	FindDirectorySyncObjectKeys           = findDirectorySyncObjectKeys
 	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
 	FindInventoryConfiguration            = findInventoryConfiguration
 	FindLifecycleRules                    = findLifecycleRules
@@ -43,4 +47,7 @@ var (
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
+
+	WithEmptyBucketConcurrency         = withEmptyBucketConcurrency
+	WithEmptyBucketLifecycleExpiration = withEmptyBucketLifecycleExpiration
 )
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy", name="Configuration Policy")
+func ResourceConfigurationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers"},
+									},
+									"enabled_control_identifiers": {
+										Type:          schema.TypeList,
+										Optional:      true,
+										Elem:          &schema.Schema{Type: schema.TypeString},
+										ConflictsWith: []string{"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers"},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("configuration_policy", flattenPolicy(output.ConfigurationPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	input := &securityhub.UpdateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d.Get("configuration_policy").([]interface{})),
+		Description:         aws.String(d.Get("description").(string)),
+		Identifier:          aws.String(d.Id()),
+		Name:                aws.String(d.Get("name").(string)),
+	}
+
+	_, err := conn.UpdateConfigurationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicy(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub.Client, id string) (*securityhub.GetConfigurationPolicyOutput, error) {
+	input := &securityhub.GetConfigurationPolicyInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicy(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandPolicy(tfList []interface{}) types.Policy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := types.SecurityHubPolicy{
+		ServiceEnabled: aws.Bool(tfMap["service_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledStandardIdentifiers = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		securityControlsConfiguration := &types.SecurityControlsConfiguration{}
+
+		if v, ok := tfMap["disabled_control_identifiers"].([]interface{}); ok && len(v) > 0 {
+			securityControlsConfiguration.DisabledSecurityControlIdentifiers = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["enabled_control_identifiers"].([]interface{}); ok && len(v) > 0 {
+			securityControlsConfiguration.EnabledSecurityControlIdentifiers = flex.ExpandStringValueList(v)
+		}
+
+		apiObject.SecurityControlsConfiguration = securityControlsConfiguration
+	}
+
+	return &types.PolicyMemberSecurityHub{
+		Value: apiObject,
+	}
+}
+
+func flattenPolicy(apiObject types.Policy) []interface{} {
+	v, ok := apiObject.(*types.PolicyMemberSecurityHub)
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns": v.Value.EnabledStandardIdentifiers,
+		"service_enabled":       aws.ToBool(v.Value.ServiceEnabled),
+	}
+
+	if v := v.Value.SecurityControlsConfiguration; v != nil {
+		tfMap["security_controls_configuration"] = []interface{}{map[string]interface{}{
+			"disabled_control_identifiers": v.DisabledSecurityControlIdentifiers,
+			"enabled_control_identifiers":  v.EnabledSecurityControlIdentifiers,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
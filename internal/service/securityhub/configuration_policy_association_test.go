@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPolicyAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy_association.test"
+	policy1ResourceName := "aws_securityhub_configuration_policy.test1"
+	policy2ResourceName := "aws_securityhub_configuration_policy.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationManagementAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyAssociationConfig_basic(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policy1ResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "data.aws_organizations_organization.current", "roots.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyAssociationConfig_basic(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_id", policy2ResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_configuration_policy_association" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindConfigurationPolicyAssociationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Configuration Policy Association (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationPolicyAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		_, err := tfsecurityhub.FindConfigurationPolicyAssociationByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccConfigurationPolicyAssociationConfig_basic(rName, policy string) string {
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+data "aws_organizations_organization" "current" {}
+
+resource "aws_securityhub_configuration_policy" "test1" {
+  name = "%[1]s-1"
+
+  configuration_policy {
+    service_enabled = true
+    enabled_standard_arns = [
+      "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0",
+    ]
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+
+resource "aws_securityhub_configuration_policy" "test2" {
+  name = "%[1]s-2"
+
+  configuration_policy {
+    service_enabled = false
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+
+resource "aws_securityhub_configuration_policy_association" "test" {
+  target_id = data.aws_organizations_organization.current.roots[0].id
+  policy_id = aws_securityhub_configuration_policy.%[2]s.id
+}
+`, rName, policy))
+}
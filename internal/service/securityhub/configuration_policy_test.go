@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccConfigurationPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationManagementAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "test description", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.enabled_standard_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.service_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "updated description", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.enabled_standard_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.security_controls_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_policy.0.service_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securityhub_configuration_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationManagementAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationPolicyConfig_basic(rName, "test description", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationPolicyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceConfigurationPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_configuration_policy" {
+				continue
+			}
+
+			_, err := tfsecurityhub.FindConfigurationPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Security Hub Configuration Policy (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigurationPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		_, err := tfsecurityhub.FindConfigurationPolicyByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// Central configuration requires a delegated administrator, cross-Region aggregation
+// and an organization configuration of type CENTRAL.
+const testAccCentralConfigurationConfig_base = `
+resource "aws_securityhub_account" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_securityhub_organization_admin_account" "test" {
+  admin_account_id = data.aws_caller_identity.current.account_id
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_finding_aggregator" "test" {
+  linking_mode = "NO_REGIONS"
+
+  depends_on = [aws_securityhub_organization_admin_account.test]
+}
+
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable           = false
+  auto_enable_standards = "NONE"
+
+  organization_configuration {
+    configuration_type = "CENTRAL"
+  }
+
+  depends_on = [aws_securityhub_finding_aggregator.test]
+}
+`
+
+func testAccConfigurationPolicyConfig_basic(rName, description string, serviceEnabled bool) string {
+	if !serviceEnabled {
+		return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  configuration_policy {
+    service_enabled = false
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, description))
+	}
+
+	return acctest.ConfigCompose(testAccCentralConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_configuration_policy" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  configuration_policy {
+    service_enabled = true
+    enabled_standard_arns = [
+      "arn:${data.aws_partition.current.partition}:securityhub:${data.aws_region.current.name}::standards/aws-foundational-security-best-practices/v/1.0.0",
+    ]
+
+    security_controls_configuration {
+      disabled_control_identifiers = []
+    }
+  }
+
+  depends_on = [aws_securityhub_organization_configuration.test]
+}
+`, rName, description))
+}
@@ -5,7 +5,9 @@ package securityhub
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
@@ -32,6 +34,11 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
 				Type:     schema.TypeBool,
@@ -43,6 +50,21 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.AutoEnableStandards](),
 			},
+			"organization_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.OrganizationConfigurationConfigurationType](),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -59,6 +81,10 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.AutoEnableStandards = types.AutoEnableStandards(v.(string))
 	}
 
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateOrganizationConfiguration(ctx, input)
 
 	if err != nil {
@@ -69,6 +95,20 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		d.SetId(meta.(*conns.AWSClient).AccountID)
 	}
 
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	var configurationType string
+	if v, ok := d.GetOk("organization_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		configurationType = v.([]interface{})[0].(map[string]interface{})["configuration_type"].(string)
+	}
+
+	if _, err := waitOrganizationConfigurationEnabled(ctx, conn, configurationType, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceOrganizationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -90,6 +130,13 @@ func resourceOrganizationConfigurationRead(ctx context.Context, d *schema.Resour
 
 	d.Set("auto_enable", output.AutoEnable)
 	d.Set("auto_enable_standards", output.AutoEnableStandards)
+	if output.OrganizationConfiguration != nil {
+		if err := d.Set("organization_configuration", []interface{}{flattenOrganizationConfiguration(output.OrganizationConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting organization_configuration: %s", err)
+		}
+	} else {
+		d.Set("organization_configuration", nil)
+	}
 
 	return diags
 }
@@ -116,3 +163,83 @@ func FindOrganizationConfiguration(ctx context.Context, conn *securityhub.Client
 
 	return output, nil
 }
+
+// statusOrganizationConfiguration returns the organization configuration's status.
+// If a configuration type is specified, the status is pending until that configuration type is reported.
+func statusOrganizationConfiguration(ctx context.Context, conn *securityhub.Client, configurationType string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationConfiguration(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Organizations that have never used central configuration don't report a status.
+		if output.OrganizationConfiguration == nil {
+			if configurationType == "" {
+				return output, string(types.OrganizationConfigurationStatusEnabled), nil
+			}
+
+			return output, string(types.OrganizationConfigurationStatusPending), nil
+		}
+
+		if configurationType != "" && string(output.OrganizationConfiguration.ConfigurationType) != configurationType {
+			return output, string(types.OrganizationConfigurationStatusPending), nil
+		}
+
+		return output, string(output.OrganizationConfiguration.Status), nil
+	}
+}
+
+func waitOrganizationConfigurationEnabled(ctx context.Context, conn *securityhub.Client, configurationType string, timeout time.Duration) (*securityhub.DescribeOrganizationConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.OrganizationConfigurationStatusPending),
+		Target:     enum.Slice(types.OrganizationConfigurationStatusEnabled),
+		Refresh:    statusOrganizationConfiguration(ctx, conn, configurationType),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*securityhub.DescribeOrganizationConfigurationOutput); ok {
+		if v := output.OrganizationConfiguration; v != nil && v.Status == types.OrganizationConfigurationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOrganizationConfiguration(tfMap map[string]interface{}) *types.OrganizationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.OrganizationConfiguration{}
+
+	if v, ok := tfMap["configuration_type"].(string); ok && v != "" {
+		apiObject.ConfigurationType = types.OrganizationConfigurationConfigurationType(v)
+	}
+
+	return apiObject
+}
+
+func flattenOrganizationConfiguration(apiObject *types.OrganizationConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"configuration_type": apiObject.ConfigurationType,
+	}
+
+	return tfMap
+}
@@ -86,6 +86,45 @@ func testAccOrganizationConfiguration_autoEnableStandards(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_centralConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securityhub_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckOrganizationManagementAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_centralConfiguration(false, "NONE", "CENTRAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "CENTRAL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_centralConfiguration(true, "DEFAULT", "LOCAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_standards", "DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "organization_configuration.0.configuration_type", "LOCAL"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOrganizationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -133,3 +172,24 @@ resource "aws_securityhub_organization_configuration" "test" {
 }
 `, autoEnableStandards))
 }
+
+func testAccOrganizationConfigurationConfig_centralConfiguration(autoEnable bool, autoEnableStandards, configurationType string) string {
+	return acctest.ConfigCompose(testAccOrganizationConfigurationConfig_base, fmt.Sprintf(`
+resource "aws_securityhub_finding_aggregator" "test" {
+  linking_mode = "NO_REGIONS"
+
+  depends_on = [aws_securityhub_organization_admin_account.test]
+}
+
+resource "aws_securityhub_organization_configuration" "test" {
+  auto_enable           = %[1]t
+  auto_enable_standards = %[2]q
+
+  organization_configuration {
+    configuration_type = %[3]q
+  }
+
+  depends_on = [aws_securityhub_finding_aggregator.test]
+}
+`, autoEnable, autoEnableStandards, configurationType))
+}
@@ -21,6 +21,19 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"Full":                        testAccAccount_full,
 			"RemoveControlFindingGeneratorDefaultValue": testAccAccount_removeControlFindingGeneratorDefaultValue,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"disappears": testAccAutomationRule_disappears,
+			"update":     testAccAutomationRule_update,
+			"tags":       testAccAutomationRule_tags,
+		},
+		"ConfigurationPolicy": {
+			"basic":      testAccConfigurationPolicy_basic,
+			"disappears": testAccConfigurationPolicy_disappears,
+		},
+		"ConfigurationPolicyAssociation": {
+			"basic": testAccConfigurationPolicyAssociation_basic,
+		},
 		"Member": {
 			"basic":  testAccMember_basic,
 			"invite": testAccMember_invite,
@@ -53,8 +66,9 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"MultiRegion": testAccOrganizationAdminAccount_MultiRegion,
 		},
 		"OrganizationConfiguration": {
-			"basic":               testAccOrganizationConfiguration_basic,
-			"AutoEnableStandards": testAccOrganizationConfiguration_autoEnableStandards,
+			"basic":                testAccOrganizationConfiguration_basic,
+			"AutoEnableStandards":  testAccOrganizationConfiguration_autoEnableStandards,
+			"CentralConfiguration": testAccOrganizationConfiguration_centralConfiguration,
 		},
 		"ProductSubscription": {
 			"basic": testAccProductSubscription_basic,
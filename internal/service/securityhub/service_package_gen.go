@@ -36,6 +36,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceActionTarget,
 			TypeName: "aws_securityhub_action_target",
 		},
+		{
+			Factory:  ResourceAutomationRule,
+			TypeName: "aws_securityhub_automation_rule",
+			Name:     "Automation Rule",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceConfigurationPolicy,
+			TypeName: "aws_securityhub_configuration_policy",
+			Name:     "Configuration Policy",
+		},
+		{
+			Factory:  ResourceConfigurationPolicyAssociation,
+			TypeName: "aws_securityhub_configuration_policy_association",
+			Name:     "Configuration Policy Association",
+		},
 		{
 			Factory:  ResourceFindingAggregator,
 			TypeName: "aws_securityhub_finding_aggregator",
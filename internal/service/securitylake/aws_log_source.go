@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="AWS Log Source")
+func newAWSLogSourceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &awsLogSourceResource{}
+
+	return r, nil
+}
+
+const (
+	ResNameAWSLogSource = "AWS Log Source"
+)
+
+type awsLogSourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *awsLogSourceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_securitylake_aws_log_source"
+}
+
+func (r *awsLogSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsLogSourceSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"accounts": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"regions": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"source_name": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+							Required:   true,
+						},
+						"source_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *awsLogSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data awsLogSourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateAwsLogSourceInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Default to the configured account if none are specified.
+	if len(input.Sources) > 0 && len(input.Sources[0].Accounts) == 0 {
+		input.Sources[0].Accounts = []string{r.Meta().AccountID}
+	}
+
+	sourceName := string(input.Sources[0].SourceName)
+	output, err := conn.CreateAwsLogSource(ctx, input)
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionCreating, ResNameAWSLogSource, sourceName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(sourceName)
+
+	// Set values for unknowns.
+	logSource, missing, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(sourceName))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameAWSLogSource, sourceName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddWarning(awsLogSourceUnevenWarningSummary, awsLogSourceUnevenWarningDetail(logSource.SourceName, missing))
+	}
+
+	var source awsLogSourceSourceModel
+	resp.Diagnostics.Append(flex.Flatten(ctx, logSource, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sources = fwtypes.NewListNestedObjectValueOfPtr(ctx, &source)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awsLogSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data awsLogSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	logSource, missing, err := findAWSLogSourceBySourceName(ctx, conn, awstypes.AwsLogSourceName(data.ID.ValueString()))
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameAWSLogSource, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddWarning(awsLogSourceUnevenWarningSummary, awsLogSourceUnevenWarningDetail(logSource.SourceName, missing))
+	}
+
+	var source awsLogSourceSourceModel
+	resp.Diagnostics.Append(flex.Flatten(ctx, logSource, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sources = fwtypes.NewListNestedObjectValueOfPtr(ctx, &source)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *awsLogSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Noop.
+}
+
+func (r *awsLogSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data awsLogSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.DeleteAwsLogSourceInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.DeleteAwsLogSource(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameAWSLogSource, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type awsLogSourceResourceModel struct {
+	ID      types.String                                             `tfsdk:"id"`
+	Sources fwtypes.ListNestedObjectValueOf[awsLogSourceSourceModel] `tfsdk:"source"`
+}
+
+type awsLogSourceSourceModel struct {
+	Accounts      fwtypes.SetValueOf[types.String]              `tfsdk:"accounts"`
+	Regions       fwtypes.SetValueOf[types.String]              `tfsdk:"regions"`
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
+
+const awsLogSourceUnevenWarningSummary = "AWS log source not enabled in every combination of accounts and Regions"
+
+func awsLogSourceUnevenWarningDetail(sourceName awstypes.AwsLogSourceName, missing []string) string {
+	return fmt.Sprintf("AWS log source (%s) is not enabled in %s. Its accounts and Regions are reported as the union of those in which it is enabled; to enable it in every combination, replace the resource, e.g. with `terraform apply -replace`.", sourceName, strings.Join(missing, ", "))
+}
+
+// findAWSLogSourceBySourceName returns the accounts and Regions in which the specified AWS log source is enabled,
+// and the combinations of those accounts and Regions in which it is not enabled.
+func findAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName awstypes.AwsLogSourceName) (*awstypes.AwsLogSourceConfiguration, []string, error) {
+	input := &securitylake.ListLogSourcesInput{
+		Sources: []awstypes.LogSourceResource{
+			&awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName: sourceName,
+				},
+			},
+		},
+	}
+
+	output, err := findLogSources(ctx, conn, input)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration, missing := expandAWSLogSourceConfigurationFromLogSources(sourceName, output)
+
+	if configuration == nil {
+		return nil, nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return configuration, missing, nil
+}
+
+// expandAWSLogSourceConfigurationFromLogSources returns the configuration of the specified AWS log source
+// from the log sources enabled per account and Region, or nil if the source is not enabled anywhere.
+// The configuration models the source as enabled in every combination of its accounts and Regions,
+// so the combinations in which the source is not enabled are also returned.
+func expandAWSLogSourceConfigurationFromLogSources(sourceName awstypes.AwsLogSourceName, logSources []awstypes.LogSource) (*awstypes.AwsLogSourceConfiguration, []string) {
+	var (
+		accounts, regions []string
+		sourceVersion     *string
+	)
+	seenAccounts, seenRegions := make(map[string]bool), make(map[string]bool)
+	seenPairs := make(map[[2]string]bool)
+
+	for _, logSource := range logSources {
+		for _, v := range logSource.Sources {
+			v, ok := v.(*awstypes.LogSourceResourceMemberAwsLogSource)
+			if !ok || v.Value.SourceName != sourceName {
+				continue
+			}
+
+			account, region := aws.ToString(logSource.Account), aws.ToString(logSource.Region)
+			if !seenAccounts[account] {
+				seenAccounts[account] = true
+				accounts = append(accounts, account)
+			}
+			if !seenRegions[region] {
+				seenRegions[region] = true
+				regions = append(regions, region)
+			}
+			seenPairs[[2]string{account, region}] = true
+			sourceVersion = v.Value.SourceVersion
+		}
+	}
+
+	if len(accounts) == 0 {
+		return nil, nil
+	}
+
+	var missing []string
+	for _, account := range accounts {
+		for _, region := range regions {
+			if !seenPairs[[2]string{account, region}] {
+				missing = append(missing, fmt.Sprintf("account (%s) and Region (%s)", account, region))
+			}
+		}
+	}
+
+	return &awstypes.AwsLogSourceConfiguration{
+		Accounts:      accounts,
+		Regions:       regions,
+		SourceName:    sourceName,
+		SourceVersion: sourceVersion,
+	}, missing
+}
+
+func findLogSources(ctx context.Context, conn *securitylake.Client, input *securitylake.ListLogSourcesInput) ([]awstypes.LogSource, error) {
+	var output []awstypes.LogSource
+
+	pages := securitylake.NewListLogSourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Sources...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsecuritylake "github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandAWSLogSourceConfigurationFromLogSources(t *testing.T) {
+	t.Parallel()
+
+	logSource := func(account, region string, sourceNames ...types.AwsLogSourceName) types.LogSource {
+		apiObject := types.LogSource{
+			Account: aws.String(account),
+			Region:  aws.String(region),
+		}
+		for _, sourceName := range sourceNames {
+			apiObject.Sources = append(apiObject.Sources, &types.LogSourceResourceMemberAwsLogSource{
+				Value: types.AwsLogSourceResource{
+					SourceName:    sourceName,
+					SourceVersion: aws.String("2.0"),
+				},
+			})
+		}
+		return apiObject
+	}
+
+	testCases := map[string]struct {
+		logSources   []types.LogSource
+		wantAccounts []string
+		wantRegions  []string
+		wantMissing  []string
+		wantNil      bool
+	}{
+		"not enabled": {
+			logSources: []types.LogSource{
+				logSource("111111111111", "us-east-1", types.AwsLogSourceNameVpcFlow),
+			},
+			wantNil: true,
+		},
+		"every combination": {
+			logSources: []types.LogSource{
+				logSource("111111111111", "us-east-1", types.AwsLogSourceNameRoute53),
+				logSource("111111111111", "us-west-2", types.AwsLogSourceNameRoute53),
+				logSource("222222222222", "us-east-1", types.AwsLogSourceNameRoute53, types.AwsLogSourceNameVpcFlow),
+				logSource("222222222222", "us-west-2", types.AwsLogSourceNameRoute53),
+			},
+			wantAccounts: []string{"111111111111", "222222222222"},
+			wantRegions:  []string{"us-east-1", "us-west-2"},
+		},
+		"uneven combinations": {
+			logSources: []types.LogSource{
+				logSource("111111111111", "us-east-1", types.AwsLogSourceNameRoute53),
+				logSource("222222222222", "us-west-2", types.AwsLogSourceNameRoute53),
+			},
+			wantAccounts: []string{"111111111111", "222222222222"},
+			wantRegions:  []string{"us-east-1", "us-west-2"},
+			wantMissing:  []string{"account (111111111111) and Region (us-west-2)", "account (222222222222) and Region (us-east-1)"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, missing := tfsecuritylake.ExpandAWSLogSourceConfigurationFromLogSources(types.AwsLogSourceNameRoute53, testCase.logSources)
+
+			if testCase.wantNil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got)
+				}
+				return
+			}
+
+			if diff := cmp.Diff(got.Accounts, testCase.wantAccounts); diff != "" {
+				t.Errorf("unexpected accounts diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.Regions, testCase.wantRegions); diff != "" {
+				t.Errorf("unexpected regions diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(missing, testCase.wantMissing); diff != "" {
+				t.Errorf("unexpected missing combinations diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func testAccAWSLogSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var logSource types.AwsLogSourceConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_aws_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAWSLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLogSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLogSourceExists(ctx, resourceName, &logSource),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "source.0.accounts.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "source.0.regions.*", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "source.0.source_name", "ROUTE53"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.source_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLogSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var logSource types.AwsLogSourceConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_aws_log_source.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAWSLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLogSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLogSourceExists(ctx, resourceName, &logSource),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecuritylake.ResourceAWSLogSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLogSource_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var logSource1, logSource2 types.AwsLogSourceConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_securitylake_aws_log_source.test"
+	resourceName2 := "aws_securitylake_aws_log_source.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAWSLogSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLogSourceConfig_multiple(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLogSourceExists(ctx, resourceName, &logSource1),
+					testAccCheckAWSLogSourceExists(ctx, resourceName2, &logSource2),
+					resource.TestCheckResourceAttr(resourceName, "source.0.source_name", "ROUTE53"),
+					resource.TestCheckResourceAttr(resourceName2, "source.0.source_name", "S3_DATA"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLogSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securitylake_aws_log_source" {
+				continue
+			}
+
+			_, _, err := tfsecuritylake.FindAWSLogSourceBySourceName(ctx, conn, types.AwsLogSourceName(rs.Primary.ID))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.SecurityLake, create.ErrActionCheckingDestroyed, tfsecuritylake.ResNameAWSLogSource, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLogSourceExists(ctx context.Context, name string, logSource *types.AwsLogSourceConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.SecurityLake, create.ErrActionCheckingExistence, tfsecuritylake.ResNameAWSLogSource, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SecurityLake, create.ErrActionCheckingExistence, tfsecuritylake.ResNameAWSLogSource, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
+		resp, _, err := tfsecuritylake.FindAWSLogSourceBySourceName(ctx, conn, types.AwsLogSourceName(rs.Primary.ID))
+		if err != nil {
+			return create.Error(names.SecurityLake, create.ErrActionCheckingExistence, tfsecuritylake.ResNameAWSLogSource, rs.Primary.ID, err)
+		}
+
+		*logSource = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSLogSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_securitylake_aws_log_source" "test" {
+  source {
+    accounts    = [data.aws_caller_identity.current.account_id]
+    regions     = [%[1]q]
+    source_name = "ROUTE53"
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+`, acctest.Region()))
+}
+
+func testAccAWSLogSourceConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccDataLakeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_securitylake_aws_log_source" "test" {
+  source {
+    accounts    = [data.aws_caller_identity.current.account_id]
+    regions     = [%[1]q]
+    source_name = "ROUTE53"
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+
+resource "aws_securitylake_aws_log_source" "test2" {
+  source {
+    accounts    = [data.aws_caller_identity.current.account_id]
+    regions     = [%[1]q]
+    source_name = "S3_DATA"
+  }
+
+  depends_on = [aws_securitylake_data_lake.test]
+}
+`, acctest.Region()))
+}
@@ -362,7 +362,8 @@ func (r *dataLakeResource) ModifyPlan(ctx context.Context, request resource.Modi
 
 func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*awstypes.DataLakeResource, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.DataLakeStatusInitialized),
+		// Organization-wide configuration can leave the data lake PENDING before it is INITIALIZED.
+		Pending: enum.Slice(awstypes.DataLakeStatusPending, awstypes.DataLakeStatusInitialized),
 		Target:  enum.Slice(awstypes.DataLakeStatusCompleted),
 		Refresh: statusDataLakeCreate(ctx, conn, arn),
 		Timeout: timeout,
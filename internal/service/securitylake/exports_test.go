@@ -5,7 +5,16 @@ package securitylake
 
 // Exports for use in tests only.
 var (
-	ResourceDataLake = newDataLakeResource
+	ResourceAWSLogSource           = newAWSLogSourceResource
+	ResourceCustomLogSource        = newCustomLogSourceResource
+	ResourceDataLake               = newDataLakeResource
+	ResourceSubscriber             = newSubscriberResource
+	ResourceSubscriberNotification = newSubscriberNotificationResource
 
-	FindDataLakeByARN = findDataLakeByARN
+	ExpandAWSLogSourceConfigurationFromLogSources = expandAWSLogSourceConfigurationFromLogSources
+	FindAWSLogSourceBySourceName                  = findAWSLogSourceBySourceName
+	FindCustomLogSourceBySourceName               = findCustomLogSourceBySourceName
+	FindDataLakeByARN                             = findDataLakeByARN
+	FindSubscriberByID                            = findSubscriberByID
+	FindSubscriberNotificationBySubscriberID      = findSubscriberNotificationBySubscriberID
 )
@@ -13,6 +13,15 @@ func TestAccSecurityLake_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"AWSLogSource": {
+			"basic":      testAccAWSLogSource_basic,
+			"disappears": testAccAWSLogSource_disappears,
+			"multiple":   testAccAWSLogSource_multiple,
+		},
+		"CustomLogSource": {
+			"basic":      testAccCustomLogSource_basic,
+			"disappears": testAccCustomLogSource_disappears,
+		},
 		"DataLake": {
 			"basic":           testAccDataLake_basic,
 			"disappears":      testAccDataLake_disappears,
@@ -21,6 +30,17 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"lifecycleUpdate": testAccDataLake_lifeCycleUpdate,
 			"replication":     testAccDataLake_replication,
 		},
+		"Subscriber": {
+			"basic":      testAccSubscriber_basic,
+			"disappears": testAccSubscriber_disappears,
+			"tags":       testAccSubscriber_tags,
+			"update":     testAccSubscriber_update,
+		},
+		"SubscriberNotification": {
+			"sqs":        testAccSubscriberNotification_sqs,
+			"https":      testAccSubscriberNotification_https,
+			"disappears": testAccSubscriberNotification_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,609 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscriber")
+// @Tags(identifierAttribute="arn")
+func newSubscriberResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &subscriberResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameSubscriber = "Subscriber"
+)
+
+type subscriberResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *subscriberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_securitylake_subscriber"
+}
+
+func (r *subscriberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"arn":                 framework.ARNAttributeComputedOnly(),
+			names.AttrID:          framework.IDAttribute(),
+			"resource_share_arn":  framework.ARNAttributeComputedOnly(),
+			"resource_share_name": stringAttributeComputedOnly(),
+			"role_arn":            framework.ARNAttributeComputedOnly(),
+			"s3_bucket_arn":       framework.ARNAttributeComputedOnly(),
+			"subscriber_description": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriber_endpoint": stringAttributeComputedOnly(),
+			"subscriber_name": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_status": stringAttributeComputedOnly(),
+			names.AttrTags:      tftags.TagsAttribute(),
+			names.AttrTagsAll:   tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"source": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[subscriberLogSourceResourceModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_log_source_resource": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberAWSLogSourceResourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("aws_log_source_resource"),
+									path.MatchRelative().AtParent().AtName("custom_log_source_resource"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AwsLogSourceName](),
+										Required:   true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"custom_log_source_resource": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberCustomLogSourceResourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_name": schema.StringAttribute{
+										Required: true,
+									},
+									"source_version": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"subscriber_identity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsIdentityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"external_id": schema.StringAttribute{
+							Required: true,
+						},
+						"principal": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *subscriberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data subscriberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	input := &securitylake.CreateSubscriberInput{
+		SubscriberDescription: flex.StringFromFramework(ctx, data.SubscriberDescription),
+		SubscriberName:        flex.StringFromFramework(ctx, data.SubscriberName),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if !data.AccessType.IsNull() && !data.AccessType.IsUnknown() {
+		input.AccessTypes = []awstypes.AccessType{data.AccessType.ValueEnum()}
+	}
+
+	var diags diag.Diagnostics
+	input.Sources, diags = data.expandSources(ctx)
+	resp.Diagnostics.Append(diags...)
+	input.SubscriberIdentity, diags = data.expandSubscriberIdentity(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionCreating, ResNameSubscriber, data.SubscriberName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Subscriber.SubscriberId)
+
+	subscriber, err := waitSubscriberCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionWaitingForCreation, ResNameSubscriber, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data subscriberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	subscriber, err := findSubscriberByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionReading, ResNameSubscriber, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.refreshFromOutput(ctx, subscriber)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *subscriberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var old, new subscriberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	if !new.Sources.Equal(old.Sources) ||
+		!new.SubscriberDescription.Equal(old.SubscriberDescription) ||
+		!new.SubscriberIdentity.Equal(old.SubscriberIdentity) ||
+		!new.SubscriberName.Equal(old.SubscriberName) {
+		input := &securitylake.UpdateSubscriberInput{
+			SubscriberDescription: flex.StringFromFramework(ctx, new.SubscriberDescription),
+			SubscriberId:          flex.StringFromFramework(ctx, new.ID),
+			SubscriberName:        flex.StringFromFramework(ctx, new.SubscriberName),
+		}
+
+		var diags diag.Diagnostics
+		input.Sources, diags = new.expandSources(ctx)
+		resp.Diagnostics.Append(diags...)
+		input.SubscriberIdentity, diags = new.expandSubscriberIdentity(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateSubscriber(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionUpdating, ResNameSubscriber, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		subscriber, err := waitSubscriberUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SecurityLake, create.ErrActionWaitingForUpdate, ResNameSubscriber, new.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(new.refreshFromOutput(ctx, subscriber)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *subscriberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data subscriberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityLakeClient(ctx)
+
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionDeleting, ResNameSubscriber, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SecurityLake, create.ErrActionWaitingForDeletion, ResNameSubscriber, data.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *subscriberResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*awstypes.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
+
+func statusSubscriber(ctx context.Context, conn *securitylake.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSubscriberByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SubscriberStatus), nil
+	}
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberUpdated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusPending),
+		Target:  enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusReady),
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*awstypes.SubscriberResource, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriberStatusActive, awstypes.SubscriberStatusDeactivated, awstypes.SubscriberStatusPending, awstypes.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type subscriberResourceModel struct {
+	AccessType            fwtypes.StringEnum[awstypes.AccessType]                          `tfsdk:"access_type"`
+	ID                    types.String                                                     `tfsdk:"id"`
+	ResourceShareARN      types.String                                                     `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                                     `tfsdk:"resource_share_name"`
+	RoleARN               types.String                                                     `tfsdk:"role_arn"`
+	S3BucketARN           types.String                                                     `tfsdk:"s3_bucket_arn"`
+	Sources               fwtypes.SetNestedObjectValueOf[subscriberLogSourceResourceModel] `tfsdk:"source"`
+	SubscriberARN         types.String                                                     `tfsdk:"arn"`
+	SubscriberDescription types.String                                                     `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                                     `tfsdk:"subscriber_endpoint"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[awsIdentityModel]                `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                                     `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String                                                     `tfsdk:"subscriber_status"`
+	Tags                  types.Map                                                        `tfsdk:"tags"`
+	TagsAll               types.Map                                                        `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+// expandSources expands the source blocks into the Security Lake log source union type.
+func (model *subscriberResourceModel) expandSources(ctx context.Context) ([]awstypes.LogSourceResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sources, d := model.Sources.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.LogSourceResource, 0, len(sources))
+
+	for _, source := range sources {
+		if v, d := source.AWSLogSourceResource.ToPtr(ctx); v != nil {
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberAwsLogSource{
+				Value: awstypes.AwsLogSourceResource{
+					SourceName:    v.SourceName.ValueEnum(),
+					SourceVersion: flex.StringFromFramework(ctx, v.SourceVersion),
+				},
+			})
+		} else {
+			diags.Append(d...)
+		}
+
+		if v, d := source.CustomLogSourceResource.ToPtr(ctx); v != nil {
+			apiObjects = append(apiObjects, &awstypes.LogSourceResourceMemberCustomLogSource{
+				Value: awstypes.CustomLogSourceResource{
+					SourceName:    flex.StringFromFramework(ctx, v.SourceName),
+					SourceVersion: flex.StringFromFramework(ctx, v.SourceVersion),
+				},
+			})
+		} else {
+			diags.Append(d...)
+		}
+	}
+
+	return apiObjects, diags
+}
+
+func (model *subscriberResourceModel) expandSubscriberIdentity(ctx context.Context) (*awstypes.AwsIdentity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	identity, d := model.SubscriberIdentity.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || identity == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.AwsIdentity{}
+	diags.Append(flex.Expand(ctx, identity, apiObject)...)
+
+	return apiObject, diags
+}
+
+func (model *subscriberResourceModel) refreshFromOutput(ctx context.Context, out *awstypes.SubscriberResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if out == nil {
+		return diags
+	}
+
+	if len(out.AccessTypes) > 0 {
+		model.AccessType = fwtypes.StringEnumValue(out.AccessTypes[0])
+	} else {
+		model.AccessType = fwtypes.StringEnumNull[awstypes.AccessType]()
+	}
+	model.ID = flex.StringToFramework(ctx, out.SubscriberId)
+	model.ResourceShareARN = flex.StringToFramework(ctx, out.ResourceShareArn)
+	model.ResourceShareName = flex.StringToFramework(ctx, out.ResourceShareName)
+	model.RoleARN = flex.StringToFramework(ctx, out.RoleArn)
+	model.S3BucketARN = flex.StringToFramework(ctx, out.S3BucketArn)
+	model.SubscriberARN = flex.StringToFramework(ctx, out.SubscriberArn)
+	model.SubscriberDescription = flex.StringToFramework(ctx, out.SubscriberDescription)
+	model.SubscriberEndpoint = flex.StringToFramework(ctx, out.SubscriberEndpoint)
+	model.SubscriberName = flex.StringToFramework(ctx, out.SubscriberName)
+	model.SubscriberStatus = flex.StringValueToFramework(ctx, out.SubscriberStatus)
+
+	sources := make([]*subscriberLogSourceResourceModel, 0, len(out.Sources))
+	for _, v := range out.Sources {
+		source := &subscriberLogSourceResourceModel{
+			AWSLogSourceResource:    fwtypes.NewListNestedObjectValueOfNull[subscriberAWSLogSourceResourceModel](ctx),
+			CustomLogSourceResource: fwtypes.NewListNestedObjectValueOfNull[subscriberCustomLogSourceResourceModel](ctx),
+		}
+
+		switch v := v.(type) {
+		case *awstypes.LogSourceResourceMemberAwsLogSource:
+			source.AWSLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &subscriberAWSLogSourceResourceModel{
+				SourceName:    fwtypes.StringEnumValue(v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		case *awstypes.LogSourceResourceMemberCustomLogSource:
+			source.CustomLogSourceResource = fwtypes.NewListNestedObjectValueOfPtr(ctx, &subscriberCustomLogSourceResourceModel{
+				SourceName:    flex.StringToFramework(ctx, v.Value.SourceName),
+				SourceVersion: flex.StringToFramework(ctx, v.Value.SourceVersion),
+			})
+		default:
+			continue
+		}
+
+		sources = append(sources, source)
+	}
+	model.Sources = fwtypes.NewSetNestedObjectValueOfSlice(ctx, sources)
+
+	if v := out.SubscriberIdentity; v != nil {
+		var identity awsIdentityModel
+		diags.Append(flex.Flatten(ctx, v, &identity)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		model.SubscriberIdentity = fwtypes.NewListNestedObjectValueOfPtr(ctx, &identity)
+	}
+
+	return diags
+}
+
+type subscriberLogSourceResourceModel struct {
+	AWSLogSourceResource    fwtypes.ListNestedObjectValueOf[subscriberAWSLogSourceResourceModel]    `tfsdk:"aws_log_source_resource"`
+	CustomLogSourceResource fwtypes.ListNestedObjectValueOf[subscriberCustomLogSourceResourceModel] `tfsdk:"custom_log_source_resource"`
+}
+
+type subscriberAWSLogSourceResourceModel struct {
+	SourceName    fwtypes.StringEnum[awstypes.AwsLogSourceName] `tfsdk:"source_name"`
+	SourceVersion types.String                                  `tfsdk:"source_version"`
+}
+
+type subscriberCustomLogSourceResourceModel struct {
+	SourceName    types.String `tfsdk:"source_name"`
+	SourceVersion types.String `tfsdk:"source_version"`
+}
+
+func stringAttributeComputedOnly() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
@@ -8,4 +8,9 @@ var (
 	ResourceDRTAccessRoleARNAssociation       = newResourceDRTAccessRoleARNAssociation
 	ResourceDRTAccessLogBucketAssociation     = newResourceDRTAccessLogBucketAssociation
 	ResourceApplicationLayerAutomaticResponse = newResourceApplicationLayerAutomaticResponse
+	ResourceProactiveEngagement               = newProactiveEngagementResource
+	ResourceSubscription                      = newSubscriptionResource
+
+	FindEmergencyContacts = findEmergencyContacts
+	FindSubscription      = findSubscription
 )
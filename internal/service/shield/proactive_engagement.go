@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Proactive Engagement")
+func newProactiveEngagementResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &proactiveEngagementResource{}, nil
+}
+
+const (
+	ResNameProactiveEngagement = "Proactive Engagement"
+)
+
+type proactiveEngagementResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *proactiveEngagementResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_shield_proactive_engagement"
+}
+
+func (r *proactiveEngagementResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"emergency_contact": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[emergencyContactModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"contact_notes": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1024),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[\w\s\.\-,:/()+@]*$`), ""),
+							},
+						},
+						"email_address": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 150),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^\S+@\S+\.\S+$`), "must be a valid email address"),
+							},
+						},
+						"phone_number": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 16),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^\+[1-9]\d{1,14}$`), "must be in E.164 format"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *proactiveEngagementResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data proactiveEngagementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	id := r.Meta().AccountID
+
+	var contacts []*shield.EmergencyContact
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.EmergencyContacts, &contacts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := putProactiveEngagement(ctx, conn, data.Enabled.ValueBool(), contacts); err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameProactiveEngagement, id, err), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *proactiveEngagementResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data proactiveEngagementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	subscription, err := findSubscription(ctx, conn)
+
+	if err == nil && subscription.ProactiveEngagementStatus == nil {
+		err = &retry.NotFoundError{}
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionReading, ResNameProactiveEngagement, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	contacts, err := findEmergencyContacts(ctx, conn)
+
+	// Once associated, the subscription reports a proactive engagement status even after removal.
+	if err == nil && len(contacts) == 0 && aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusDisabled {
+		err = &retry.NotFoundError{}
+	}
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionReading, ResNameProactiveEngagement, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	data.Enabled = types.BoolValue(aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, contacts, &data.EmergencyContacts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *proactiveEngagementResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new proactiveEngagementResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	if !new.Enabled.Equal(old.Enabled) || !new.EmergencyContacts.Equal(old.EmergencyContacts) {
+		var contacts []*shield.EmergencyContact
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.EmergencyContacts, &contacts)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := putProactiveEngagement(ctx, conn, new.Enabled.ValueBool(), contacts); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionUpdating, ResNameProactiveEngagement, new.ID.ValueString(), err), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *proactiveEngagementResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data proactiveEngagementResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	subscription, err := findSubscription(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameProactiveEngagement, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	if aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+		_, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{})
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameProactiveEngagement, data.ID.ValueString(), err), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameProactiveEngagement, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+}
+
+// putProactiveEngagement configures the emergency contacts and then enables or disables proactive engagement.
+func putProactiveEngagement(ctx context.Context, conn *shield.Shield, enable bool, contacts []*shield.EmergencyContact) error {
+	subscription, err := findSubscription(ctx, conn)
+
+	if err != nil {
+		return fmt.Errorf("reading Shield Subscription: %w", err)
+	}
+
+	// Proactive engagement details can only be associated once. Subsequent changes update the emergency contacts.
+	if subscription.ProactiveEngagementStatus == nil {
+		_, err := conn.AssociateProactiveEngagementDetailsWithContext(ctx, &shield.AssociateProactiveEngagementDetailsInput{
+			EmergencyContactList: contacts,
+		})
+
+		if err != nil {
+			return fmt.Errorf("associating proactive engagement details: %w", err)
+		}
+
+		subscription, err = findSubscription(ctx, conn)
+
+		if err != nil {
+			return fmt.Errorf("reading Shield Subscription: %w", err)
+		}
+	} else {
+		_, err := conn.UpdateEmergencyContactSettingsWithContext(ctx, &shield.UpdateEmergencyContactSettingsInput{
+			EmergencyContactList: contacts,
+		})
+
+		if err != nil {
+			return fmt.Errorf("updating emergency contact settings: %w", err)
+		}
+	}
+
+	enabled := aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled
+
+	switch {
+	case enable && !enabled:
+		if _, err := conn.EnableProactiveEngagementWithContext(ctx, &shield.EnableProactiveEngagementInput{}); err != nil {
+			return fmt.Errorf("enabling proactive engagement: %w", err)
+		}
+	case !enable && enabled:
+		if _, err := conn.DisableProactiveEngagementWithContext(ctx, &shield.DisableProactiveEngagementInput{}); err != nil {
+			return fmt.Errorf("disabling proactive engagement: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func findEmergencyContacts(ctx context.Context, conn *shield.Shield) ([]*shield.EmergencyContact, error) {
+	input := &shield.DescribeEmergencyContactSettingsInput{}
+
+	output, err := conn.DescribeEmergencyContactSettingsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EmergencyContactList, nil
+}
+
+type proactiveEngagementResourceModel struct {
+	EmergencyContacts fwtypes.ListNestedObjectValueOf[emergencyContactModel] `tfsdk:"emergency_contact"`
+	Enabled           types.Bool                                             `tfsdk:"enabled"`
+	ID                types.String                                           `tfsdk:"id"`
+}
+
+type emergencyContactModel struct {
+	ContactNotes types.String `tfsdk:"contact_notes"`
+	EmailAddress types.String `tfsdk:"email_address"`
+	PhoneNumber  types.String `tfsdk:"phone_number"`
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccShieldProactiveEngagement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProactiveEngagementExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Notes"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "test1@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12358132134"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", "test2@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProactiveEngagementConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProactiveEngagementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccShieldProactiveEngagement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProactiveEngagementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProactiveEngagementExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfshield.ResourceProactiveEngagement, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProactiveEngagementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_shield_proactive_engagement" {
+				continue
+			}
+
+			subscription, err := tfshield.FindSubscription(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+				return fmt.Errorf("Shield Proactive Engagement (%s) still enabled", rs.Primary.ID)
+			}
+
+			contacts, err := tfshield.FindEmergencyContacts(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if len(contacts) > 0 {
+				return fmt.Errorf("Shield Proactive Engagement (%s) emergency contacts still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProactiveEngagementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		subscription, err := tfshield.FindSubscription(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if subscription.ProactiveEngagementStatus == nil {
+			return fmt.Errorf("Shield Proactive Engagement not configured")
+		}
+
+		return nil
+	}
+}
+
+func testAccProactiveEngagementConfig_basic(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = ""
+      Effect = "Allow"
+      Principal = {
+        Service = "drt.shield.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSShieldDRTAccessPolicy"
+}
+
+resource "aws_shield_drt_access_role_arn_association" "test" {
+  role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[2]t
+
+  emergency_contact {
+    contact_notes = "Notes"
+    email_address = "test1@example.com"
+    phone_number  = "+12358132134"
+  }
+
+  emergency_contact {
+    email_address = "test2@example.com"
+    phone_number  = "+12358132134"
+  }
+
+  depends_on = [aws_shield_drt_access_role_arn_association.test]
+}
+`, rName, enabled)
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscription")
+func newSubscriptionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &subscriptionResource{}, nil
+}
+
+const (
+	ResNameSubscription = "Subscription"
+)
+
+type subscriptionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *subscriptionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_shield_subscription"
+}
+
+func (r *subscriptionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_renew": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(shield.AutoRenewEnabled),
+				Validators: []validator.String{
+					stringvalidator.OneOf(shield.AutoRenew_Values()...),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"skip_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *subscriptionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	id := r.Meta().AccountID
+
+	_, err := conn.CreateSubscriptionWithContext(ctx, &shield.CreateSubscriptionInput{})
+
+	// An account can only have one subscription. Adopt an existing one.
+	if err != nil && !tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceAlreadyExistsException) {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameSubscription, id, err), err.Error())
+
+		return
+	}
+
+	if err := updateSubscriptionAutoRenew(ctx, conn, data.AutoRenew.ValueString()); err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionCreating, ResNameSubscription, id, err), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *subscriptionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	output, err := findSubscription(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionReading, ResNameSubscription, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+
+	data.AutoRenew = fwflex.StringToFramework(ctx, output.AutoRenew)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *subscriptionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new subscriptionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	if !new.AutoRenew.Equal(old.AutoRenew) {
+		if err := updateSubscriptionAutoRenew(ctx, conn, new.AutoRenew.ValueString()); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionUpdating, ResNameSubscription, new.ID.ValueString(), err), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *subscriptionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data subscriptionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Unsubscribing from Shield Advanced ends the annual commitment protections, so it must be explicitly allowed.
+	if data.SkipDestroy.IsNull() || data.SkipDestroy.ValueBool() {
+		response.Diagnostics.AddWarning(
+			"Shield Advanced subscription retained",
+			"The Shield Advanced subscription has been removed from Terraform state but remains active in AWS. Set skip_destroy to false to unsubscribe on destroy.",
+		)
+
+		return
+	}
+
+	conn := r.Meta().ShieldConn(ctx)
+
+	_, err := conn.DeleteSubscriptionWithContext(ctx, &shield.DeleteSubscriptionInput{}) //nolint:staticcheck // The API is deprecated but is the only way to unsubscribe.
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.Shield, create.ErrActionDeleting, ResNameSubscription, data.ID.ValueString(), err), err.Error())
+
+		return
+	}
+}
+
+func (r *subscriptionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r.WithImportByID.ImportState(ctx, request, response)
+
+	// Default to the safe behavior for imported subscriptions.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("skip_destroy"), true)...)
+}
+
+func updateSubscriptionAutoRenew(ctx context.Context, conn *shield.Shield, autoRenew string) error {
+	_, err := conn.UpdateSubscriptionWithContext(ctx, &shield.UpdateSubscriptionInput{
+		AutoRenew: aws.String(autoRenew),
+	})
+
+	return err
+}
+
+func findSubscription(ctx context.Context, conn *shield.Shield) (*shield.Subscription, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscriptionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscription, nil
+}
+
+type subscriptionResourceModel struct {
+	AutoRenew   types.String `tfsdk:"auto_renew"`
+	ID          types.String `tfsdk:"id"`
+	SkipDestroy types.Bool   `tfsdk:"skip_destroy"`
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+)
+
+// Subscribing to Shield Advanced incurs a one-year commitment, so these tests
+// only run against accounts that are already subscribed.
+func TestAccShieldSubscription_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_shield_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionRetained(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewEnabled),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewDisabled),
+				),
+			},
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubscriptionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewEnabled),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSubscriptionRetained verifies that destroying the resource with the default
+// skip_destroy setting leaves the subscription in place.
+func testAccCheckSubscriptionRetained(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_shield_subscription" {
+				continue
+			}
+
+			if _, err := tfshield.FindSubscription(ctx, conn); err != nil {
+				return fmt.Errorf("Shield Subscription (%s) was not retained: %w", rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriptionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn(ctx)
+
+		_, err := tfshield.FindSubscription(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccSubscriptionConfig_basic(autoRenew string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_subscription" "test" {
+  auto_renew = %[1]q
+}
+`, autoRenew)
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudfrontkeyvaluestore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudsearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudtrail"
@@ -155,6 +156,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/osis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
@@ -260,6 +262,7 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		cloudcontrol.ServicePackage(ctx),
 		cloudformation.ServicePackage(ctx),
 		cloudfront.ServicePackage(ctx),
+		cloudfrontkeyvaluestore.ServicePackage(ctx),
 		cloudhsmv2.ServicePackage(ctx),
 		cloudsearch.ServicePackage(ctx),
 		cloudtrail.ServicePackage(ctx),
@@ -374,6 +377,7 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		opensearchserverless.ServicePackage(ctx),
 		opsworks.ServicePackage(ctx),
 		organizations.ServicePackage(ctx),
+		osis.ServicePackage(ctx),
 		outposts.ServicePackage(ctx),
 		pinpoint.ServicePackage(ctx),
 		pipes.ServicePackage(ctx),